@@ -0,0 +1,110 @@
+// Parse chord symbols into a tonic, a chord type and an optional bass note.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/chord/index.ts
+package chord
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+)
+
+// Chord is a parsed chord symbol.
+//
+// Tonic and Bass keep the spelling used in the symbol ("Bb", "F#"). Type is the
+// chord type part of the symbol as written ("maj7", "-7", ""), and ChordType is
+// the dictionary entry it refers to, or chordtype.NoChordType when the type is
+// not known.
+type Chord struct {
+	Empty     bool
+	Symbol    string
+	Tonic     string
+	Type      string
+	Bass      string
+	ChordType chordtype.ChordType
+}
+
+var NoChord = Chord{
+	Empty:     true,
+	Symbol:    "",
+	Tonic:     "",
+	Type:      "",
+	Bass:      "",
+	ChordType: chordtype.NoChordType,
+}
+
+// A note name: an uppercase letter followed by sharps or flats (ASCII or Unicode).
+const noteNameRegex = `[A-G](?:#+|x+|b+|♯+|♭+)?`
+
+var (
+	tonicRegex = regexp.MustCompile(`^` + noteNameRegex)
+	bassRegex  = regexp.MustCompile(`^` + noteNameRegex + `$`)
+)
+
+// Tokenize splits a chord symbol into tonic, type and bass.
+//
+// For example, "Cmaj7/E" gives ["C", "maj7", "E"] and "Bb6/9" gives ["Bb", "6/9", ""].
+// A trailing "/x" is only taken as the bass when x is a note name.
+// If the symbol doesn't start with a note name, the tonic is empty and the whole
+// symbol is returned as the type.
+func Tokenize(symbol string) [3]string {
+	symbol = strings.TrimSpace(symbol)
+
+	tonic := tonicRegex.FindString(symbol)
+	rest := symbol[len(tonic):]
+
+	bass := ""
+	if slash := strings.LastIndex(rest, "/"); slash != -1 {
+		if candidate := rest[slash+1:]; bassRegex.MatchString(candidate) {
+			bass = candidate
+			rest = rest[:slash]
+		}
+	}
+
+	return [3]string{tonic, rest, bass}
+}
+
+// Get parses a chord symbol such as "C#m7/E".
+//
+// Returns NoChord if the symbol has no tonic. If the tonic is valid but the type
+// is not in the chord type dictionary, the returned chord is not empty but its
+// ChordType is empty, so callers can tell an unknown alias from garbage.
+func Get(symbol string) Chord {
+	tokens := Tokenize(symbol)
+	if tokens[0] == "" {
+		return NoChord
+	}
+
+	return Chord{
+		Empty:     false,
+		Symbol:    strings.TrimSpace(symbol),
+		Tonic:     tokens[0],
+		Type:      tokens[1],
+		Bass:      tokens[2],
+		ChordType: chordtype.Get(tokens[1]),
+	}
+}
+
+// Known reports whether the chord type of the chord is in the dictionary.
+func (c Chord) Known() bool {
+	return !c.Empty && !c.ChordType.Empty
+}
+
+// Name returns the full name of the chord, e.g. "C major seventh over E".
+// Chord types without a full name use their first alias instead.
+func (c Chord) Name() string {
+	if !c.Known() {
+		return ""
+	}
+	return chordtype.Classical.Format(c.Tonic, c.ChordType, c.Bass)
+}
+
+// Format writes the chord in a style: "Cmaj7/E" is "CΔ7/E" in the jazz style
+// and "C major seventh over E" in the classical one. Returns the symbol as
+// written if the chord is not known.
+func (c Chord) Format(style chordtype.Style) string {
+	if !c.Known() {
+		return c.Symbol
+	}
+	return style.Format(c.Tonic, c.ChordType, c.Bass)
+}
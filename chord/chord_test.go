@@ -0,0 +1,83 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected [3]string
+	}{
+		{"Cmaj7", [3]string{"C", "maj7", ""}},
+		{"C#m7/E", [3]string{"C#", "m7", "E"}},
+		{"Bb", [3]string{"Bb", "", ""}},
+		{"Bbm7b5", [3]string{"Bb", "m7b5", ""}},
+		{"Eb6/9", [3]string{"Eb", "6/9", ""}},
+		{"Cm/maj7", [3]string{"C", "m/maj7", ""}},
+		{"F♯m/C♯", [3]string{"F♯", "m", "C♯"}},
+		{"maj7", [3]string{"", "maj7", ""}},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Tokenize(tc.input), "Input: %s", tc.input)
+	}
+}
+
+func TestGet(t *testing.T) {
+	t.Run("known chords", func(t *testing.T) {
+		c := Get("C#m7/E")
+		assert.True(t, c.Known())
+		assert.Equal(t, "C#", c.Tonic)
+		assert.Equal(t, "E", c.Bass)
+		assert.Equal(t, "minor seventh", c.ChordType.Name)
+		assert.Equal(t, "C# minor seventh over E", c.Name())
+
+		assert.Equal(t, "major", Get("D").ChordType.Name)
+		assert.Equal(t, "half-diminished", Get("Fø").ChordType.Name)
+	})
+
+	t.Run("unknown chord types", func(t *testing.T) {
+		c := Get("Cfoo")
+		assert.False(t, c.Empty)
+		assert.False(t, c.Known())
+		assert.Equal(t, "foo", c.Type)
+		assert.Equal(t, "", c.Name())
+	})
+
+	t.Run("not a chord", func(t *testing.T) {
+		assert.True(t, Get("").Empty)
+		assert.True(t, Get("hello").Empty)
+	})
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		symbol   string
+		style    chordtype.Style
+		expected string
+	}{
+		{"Cmaj7/E", chordtype.Style{}, "Cmaj7/E"},
+		{"Cmaj7/E", chordtype.Jazz, "CΔ7/E"},
+		{"Dm7b5", chordtype.Jazz, "Dø7"},
+		{"Bdim7", chordtype.Jazz, "B°7"},
+		{"Am7", chordtype.Jazz, "A-7"},
+		{"CM", chordtype.Jazz, "C"},
+		{"CΔ7", chordtype.Pop, "Cmaj7"},
+		{"Dø", chordtype.Pop, "Dm7b5"},
+		{"Bo7", chordtype.Pop, "Bdim7"},
+		{"Dm7b5", chordtype.Berklee, "D-7(b5)"},
+		{"EmMaj7", chordtype.Berklee, "E-(maj7)"},
+		{"G7b9", chordtype.Berklee, "G7(b9)"},
+		{"Cmaj7/E", chordtype.Classical, "C major seventh over E"},
+		{"C7b5", chordtype.Classical, "C 7b5"},
+		{"Cfoo", chordtype.Jazz, "Cfoo"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Get(test.symbol).Format(test.style), test.symbol)
+	}
+}
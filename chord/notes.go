@@ -0,0 +1,90 @@
+package chord
+
+import (
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+)
+
+// Notes returns the notes of the chord, spelled from the tonic and the intervals
+// of its chord type: "Dm7b5" is D F Ab C, "Abm" is Ab Cb Eb and "D#7" is
+// D# F## A# C#.
+//
+// Slash chords start with the bass: when the bass is spelled like a chord tone,
+// that tone is moved first ("C/E" is E G C), otherwise the bass is added before
+// the chord tones ("C/D" is D C E G).
+//
+// Returns nil if the chord is not known.
+func (c Chord) Notes() []pitch.Note {
+	if !c.Known() {
+		return nil
+	}
+	tonic, err := pitch.ParseNote(c.Tonic)
+	if err != nil {
+		return nil
+	}
+
+	notes := make([]pitch.Note, 0, len(c.ChordType.Intervals)+1)
+	for _, name := range c.ChordType.Intervals {
+		interval := pitchinterval.Parse(name)
+		if interval.Empty {
+			continue
+		}
+		notes = append(notes, pitch.NoteOf(pitch.Decode(tonic.Coordinates().Add(interval.Coord))))
+	}
+
+	bass, err := pitch.ParseNote(c.Bass)
+	if err != nil {
+		return notes
+	}
+	for i, n := range notes {
+		if n == bass.PitchClass() {
+			return append(notes[i:], notes[:i]...)
+		}
+	}
+	return append([]pitch.Note{bass.PitchClass()}, notes...)
+}
+
+// NoteNames returns the names of the notes of the chord, see Notes.
+func (c Chord) NoteNames() []string {
+	notes := c.Notes()
+	if notes == nil {
+		return nil
+	}
+	names := make([]string, len(notes))
+	for i, n := range notes {
+		names[i] = n.String()
+	}
+	return names
+}
+
+// WithTonic writes the chord with another spelling of its tonic, such as a
+// detected "C#m7" as "Dbm7" or "B7" as "Cb7". The bass is respelled the same
+// way, so that the chord tones keep their intervals: "C#7/E#" becomes "Db7/F".
+//
+// Returns the chord unchanged if it is empty or the tonic is not an enharmonic
+// spelling of its tonic.
+func WithTonic(c Chord, tonic string) Chord {
+	if c.Empty {
+		return c
+	}
+	from, errFrom := pitch.ParseNote(c.Tonic)
+	to, errTo := pitch.ParseNote(tonic)
+	if errFrom != nil || errTo != nil || from.Chroma() != to.Chroma() {
+		return c
+	}
+	to = to.PitchClass()
+	shift := to.Coordinates().Fifths - from.PitchClass().Coordinates().Fifths
+
+	respelled := c
+	respelled.Tonic = to.String()
+	if bass, err := pitch.ParseNote(c.Bass); err == nil {
+		coord := bass.PitchClass().Coordinates().Add(pitch.Coordinates{Fifths: shift})
+		respelled.Bass = pitch.NoteOf(pitch.Decode(coord)).String()
+	}
+
+	respelled.Symbol = respelled.Tonic + respelled.Type
+	if respelled.Bass != "" {
+		respelled.Symbol += "/" + respelled.Bass
+	}
+	return respelled
+}
@@ -0,0 +1,65 @@
+package chord
+
+import (
+	"testing"
+
+	detector "github.com/Golevka2001/go-chord-detector"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotes(t *testing.T) {
+	testCases := []struct {
+		symbol   string
+		expected []string
+	}{
+		{"C", []string{"C", "E", "G"}},
+		{"Dm7b5", []string{"D", "F", "Ab", "C"}},
+		{"Abm", []string{"Ab", "Cb", "Eb"}},
+		{"D#7", []string{"D#", "F##", "A#", "C#"}},
+		{"Cbdim7", []string{"Cb", "Ebb", "Gbb", "Bbbb"}},
+		{"F♯m", []string{"F#", "A", "C#"}},
+		{"Bb9", []string{"Bb", "D", "F", "Ab", "C"}},
+		{"Cmaj7/E", []string{"E", "G", "B", "C"}},
+		{"C/D", []string{"D", "C", "E", "G"}},
+		{"C/Fb", []string{"Fb", "C", "E", "G"}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Get(tc.symbol).NoteNames(), tc.symbol)
+	}
+
+	assert.Equal(t, "F𝄪", Get("D#7").Notes()[1].Unicode())
+	assert.Nil(t, Get("Cfoo").Notes())
+	assert.Nil(t, NoChord.NoteNames())
+}
+
+func TestNotesOfDetectedChords(t *testing.T) {
+	notes := []pitch.Note{pitch.MustParseNote("Ab"), pitch.MustParseNote("Cb"), pitch.MustParseNote("Eb")}
+	detected := detector.DetectPitches(notes)
+	assert.Equal(t, []string{"Ab", "Cb", "Eb"}, Get(detected[0]).NoteNames())
+
+	// Detection with note.Class spells with sharps; the caller chooses the root.
+	c := WithTonic(Get("G#m"), "Ab")
+	assert.Equal(t, "Abm", c.Symbol)
+	assert.Equal(t, []string{"Ab", "Cb", "Eb"}, c.NoteNames())
+}
+
+func TestWithTonic(t *testing.T) {
+	testCases := []struct {
+		symbol   string
+		tonic    string
+		expected string
+	}{
+		{"C#m7", "Db", "Dbm7"},
+		{"B7", "Cb", "Cb7"},
+		{"C#7/E#", "Db", "Db7/F"},
+		{"Eb/G", "D#", "D#/F##"},
+		{"C", "B#", "B#"},
+		{"C", "Db", "C"},
+		{"C", "H", "C"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, WithTonic(Get(tc.symbol), tc.tonic).Symbol, "%s as %s", tc.symbol, tc.tonic)
+	}
+	assert.Equal(t, NoChord, WithTonic(NoChord, "C"))
+}
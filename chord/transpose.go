@@ -0,0 +1,176 @@
+package chord
+
+import (
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+)
+
+type Accidentals int
+
+const (
+	// AsIs keeps the spelling given by the interval (Bb up a major second is C).
+	// When transposing by semitones, the accidental of the original tonic is kept.
+	AsIs Accidentals = iota
+	Sharps
+	Flats
+)
+
+// Spelling chooses how transposed notes are written.
+//
+// When Key is set ("Eb", "F#m"), notes are written as they are usually written
+// in that key: in C, the notes are spelled Db Eb F# Ab Bb; in E, C# D# F# G# A#...
+// Otherwise notes are written with Accidentals.
+type Spelling struct {
+	Key         string
+	Accidentals Accidentals
+}
+
+// Transpose moves the tonic and the bass of a chord by an interval.
+//
+// Returns the chord unchanged if it is empty or the interval is empty.
+func Transpose(c Chord, interval pitchinterval.Interval, spelling Spelling) Chord {
+	if c.Empty || interval.Empty {
+		return c
+	}
+	return move(c, interval.Fifths(), spelling)
+}
+
+// TransposeSemitones moves the tonic and the bass of a chord by a number of semitones.
+func TransposeSemitones(c Chord, semitones int, spelling Spelling) Chord {
+	if c.Empty {
+		return c
+	}
+	if spelling.Key == "" && spelling.Accidentals == AsIs {
+		spelling.Accidentals = Sharps
+		if strings.ContainsAny(c.Tonic, "b♭") {
+			spelling.Accidentals = Flats
+		}
+	}
+	return move(c, semitonesToFifths(semitones), spelling)
+}
+
+// TransposeSequence moves every chord of a progression by an interval.
+func TransposeSequence(chords []Chord, interval pitchinterval.Interval, spelling Spelling) []Chord {
+	result := make([]Chord, len(chords))
+	for i, c := range chords {
+		result[i] = Transpose(c, interval, spelling)
+	}
+	return result
+}
+
+// TransposeSequenceSemitones moves every chord of a progression by a number of semitones.
+func TransposeSequenceSemitones(chords []Chord, semitones int, spelling Spelling) []Chord {
+	result := make([]Chord, len(chords))
+	for i, c := range chords {
+		result[i] = TransposeSemitones(c, semitones, spelling)
+	}
+	return result
+}
+
+// Respell writes the tonic and the bass of a chord with the given spelling,
+// without changing the pitches: Respell(Get("A#m"), Spelling{Accidentals: Flats}) is "Bbm".
+func Respell(c Chord, spelling Spelling) Chord {
+	if c.Empty {
+		return c
+	}
+	return move(c, 0, spelling)
+}
+
+func move(c Chord, shift int, spelling Spelling) Chord {
+	tonic, ok := NoteFifths(c.Tonic)
+	if !ok {
+		return c
+	}
+	lowest, respell := spellingRange(spelling)
+
+	place := func(fifths int) string {
+		coord := pitch.Coordinates{Fifths: fifths + shift}
+		if respell {
+			coord = coord.Respell(lowest)
+		}
+		return FifthsNote(coord.Fifths)
+	}
+
+	moved := c
+	moved.Tonic = place(tonic)
+	if bass, ok := NoteFifths(c.Bass); ok {
+		moved.Bass = place(bass)
+	}
+
+	moved.Symbol = moved.Tonic + moved.Type
+	if moved.Bass != "" {
+		moved.Symbol += "/" + moved.Bass
+	}
+	return moved
+}
+
+// spellingRange returns the lowest of the 12 consecutive fifths notes are written with.
+func spellingRange(spelling Spelling) (int, bool) {
+	if spelling.Key != "" {
+		if key, ok := KeyFifths(spelling.Key); ok {
+			// From five fifths below the key to six above: Db to F# in C.
+			return key - 5, true
+		}
+	}
+
+	switch spelling.Accidentals {
+	case Sharps:
+		// F C G D A E B F# C# G# D# A#
+		return -1, true
+	case Flats:
+		// Gb Db Ab Eb Bb F C G D A E B
+		return -6, true
+	}
+	return 0, false
+}
+
+// KeyFifths returns the number of sharps (positive) or flats (negative) of a key
+// written as a chord symbol: "A" is 3, "Eb" is -3, "Cm" is -3.
+func KeyFifths(key string) (int, bool) {
+	c := Get(key)
+	fifths, ok := NoteFifths(c.Tonic)
+	if !ok {
+		return 0, false
+	}
+	if c.ChordType.Quality == chordtype.Minor {
+		fifths -= 3
+	}
+	return fifths, true
+}
+
+// Notes and intervals are placed on the line of fifths (see the pitch package):
+// C is 0, G is 1, F is -1, and every sharp adds 7.
+
+// NoteFifths returns the position of a note name on the line of fifths: "C" is 0,
+// "G" is 1, "Bb" is -2 and "F#" is 6. Names with an octave are not notes of
+// chords.
+func NoteFifths(name string) (int, bool) {
+	n, err := pitch.ParseNote(name)
+	if err != nil || n.HasOct {
+		return 0, false
+	}
+	return n.Coordinates().Fifths, true
+}
+
+// FifthsNote returns the note name at a position of the line of fifths.
+// Double sharps and flats are written with their enharmonic equivalent.
+func FifthsNote(fifths int) string {
+	coord := pitch.Coordinates{Fifths: fifths}
+	switch {
+	case fifths > 12:
+		// From G to B#.
+		coord = coord.Respell(1)
+	case fifths < -8:
+		// From Fb to A.
+		coord = coord.Respell(-8)
+	}
+	return pitch.NoteOf(pitch.Decode(coord)).String()
+}
+
+func semitonesToFifths(semitones int) int {
+	// A fifth is 7 semitones and 7 * 7 = 49 = 1 (mod 12).
+	return (((semitones * 7) % 12) + 12) % 12
+}
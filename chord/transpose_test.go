@@ -0,0 +1,97 @@
+package chord
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/stretchr/testify/assert"
+)
+
+func symbols(chords []Chord) []string {
+	result := make([]string, len(chords))
+	for i, c := range chords {
+		result[i] = c.Symbol
+	}
+	return result
+}
+
+func TestTranspose(t *testing.T) {
+	t.Run("by interval", func(t *testing.T) {
+		testCases := []struct {
+			symbol   string
+			interval string
+			expected string
+		}{
+			{"C#m7/E", "2M", "D#m7/F#"},
+			{"Bbmaj7", "2M", "Cmaj7"},
+			{"C", "2m", "Db"},
+			{"C", "1A", "C#"},
+			{"F#7/A#", "-2M", "E7/G#"},
+			{"Ebm", "3M", "Gm"},
+			{"G7", "9M", "A7"},
+			{"Cfoo/E", "5P", "Gfoo/B"},
+		}
+		for _, tc := range testCases {
+			c := Transpose(Get(tc.symbol), pitchinterval.Parse(tc.interval), Spelling{})
+			assert.Equal(t, tc.expected, c.Symbol, "%s + %s", tc.symbol, tc.interval)
+		}
+
+		c := Transpose(Get("C#m7/E"), pitchinterval.Parse("2M"), Spelling{})
+		assert.Equal(t, "D#", c.Tonic)
+		assert.Equal(t, "F#", c.Bass)
+		assert.Equal(t, "minor seventh", c.ChordType.Name)
+	})
+
+	t.Run("by semitones", func(t *testing.T) {
+		assert.Equal(t, "D#m7/F#", TransposeSemitones(Get("C#m7/E"), 2, Spelling{}).Symbol)
+		assert.Equal(t, "Ebm7/Gb", TransposeSemitones(Get("Dbm7/Fb"), 2, Spelling{}).Symbol)
+		assert.Equal(t, "Bb7", TransposeSemitones(Get("C7"), -2, Spelling{Accidentals: Flats}).Symbol)
+		assert.Equal(t, "A#7", TransposeSemitones(Get("C7"), 10, Spelling{Accidentals: Sharps}).Symbol)
+		assert.Equal(t, "C", TransposeSemitones(Get("C"), 12, Spelling{}).Symbol)
+	})
+
+	t.Run("for a key", func(t *testing.T) {
+		progression := []Chord{Get("C"), Get("A7"), Get("Dm"), Get("G7")}
+
+		result := TransposeSequence(progression, pitchinterval.Parse("2m"), Spelling{Key: "Db"})
+		assert.Equal(t, []string{"Db", "Bb7", "Ebm", "Ab7"}, symbols(result))
+
+		result = TransposeSequenceSemitones(progression, 1, Spelling{Key: "Db"})
+		assert.Equal(t, []string{"Db", "Bb7", "Ebm", "Ab7"}, symbols(result))
+
+		result = TransposeSequenceSemitones(progression, 4, Spelling{Key: "E"})
+		assert.Equal(t, []string{"E", "C#7", "F#m", "B7"}, symbols(result))
+
+		result = TransposeSequenceSemitones([]Chord{Get("Am"), Get("E7/G#")}, 1, Spelling{Key: "Bbm"})
+		assert.Equal(t, []string{"Bbm", "F7/A"}, symbols(result))
+	})
+
+	t.Run("empty chords", func(t *testing.T) {
+		assert.Equal(t, NoChord, Transpose(NoChord, pitchinterval.Parse("2M"), Spelling{}))
+		assert.Equal(t, "C", Transpose(Get("C"), pitchinterval.Nointerval, Spelling{}).Symbol)
+	})
+}
+
+func TestRespell(t *testing.T) {
+	assert.Equal(t, "Bbm", Respell(Get("A#m"), Spelling{Accidentals: Flats}).Symbol)
+	assert.Equal(t, "F#7/A#", Respell(Get("Gb7/Bb"), Spelling{Accidentals: Sharps}).Symbol)
+	assert.Equal(t, "Ab", Respell(Get("G#"), Spelling{Key: "Eb"}).Symbol)
+	assert.Equal(t, "C#m", Respell(Get("C#m"), Spelling{}).Symbol)
+}
+
+func TestKeyFifths(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected int
+	}{
+		{"C", 0}, {"A", 3}, {"Eb", -3}, {"Cm", -3}, {"F#m", 3}, {"C#", 7},
+	}
+	for _, tc := range testCases {
+		fifths, ok := KeyFifths(tc.key)
+		assert.True(t, ok)
+		assert.Equal(t, tc.expected, fifths, "Key: %s", tc.key)
+	}
+
+	_, ok := KeyFifths("H")
+	assert.False(t, ok)
+}
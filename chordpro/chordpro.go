@@ -0,0 +1,292 @@
+// Read and write songs in the ChordPro format.
+// Reference: https://www.chordpro.org/chordpro/chordpro-file-format-specification/
+package chordpro
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type LineKind int
+
+const (
+	EmptyLine LineKind = iota
+	LyricsLine
+	DirectiveLine
+	CommentLine
+)
+
+// Directive is a "{name}" or "{name: value}" line.
+//
+// Name keeps the spelling used in the source, so abbreviations such as "t" or
+// "soc" are written back unchanged. Use Canonical to compare directives.
+type Directive struct {
+	Name     string
+	Value    string
+	HasValue bool
+}
+
+// Segment is a chord followed by the lyrics sung on it.
+//
+// The first segment of a line has an empty Chord when the line doesn't start
+// with a chord.
+type Segment struct {
+	Chord  string
+	Lyrics string
+}
+
+// Line is a single line of a song. Only the fields matching Kind are set.
+type Line struct {
+	Kind      LineKind
+	Directive Directive
+	Segments  []Segment
+	Comment   string
+}
+
+// Song is a parsed ChordPro file, kept line by line so it can be written back.
+type Song struct {
+	Lines []Line
+}
+
+// ParseError reports a malformed line.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("chordpro: line %d: %s", e.Line, e.Msg)
+}
+
+var abbreviations = map[string]string{
+	"t":    "title",
+	"st":   "subtitle",
+	"c":    "comment",
+	"ci":   "comment_italic",
+	"cb":   "comment_box",
+	"soc":  "start_of_chorus",
+	"eoc":  "end_of_chorus",
+	"sov":  "start_of_verse",
+	"eov":  "end_of_verse",
+	"sob":  "start_of_bridge",
+	"eob":  "end_of_bridge",
+	"sot":  "start_of_tab",
+	"eot":  "end_of_tab",
+	"sog":  "start_of_grid",
+	"eog":  "end_of_grid",
+	"np":   "new_page",
+	"npp":  "new_physical_page",
+	"col":  "columns",
+	"colb": "column_break",
+}
+
+// Canonical returns the full lowercase name of the directive ("t" => "title").
+func (d Directive) Canonical() string {
+	name := strings.ToLower(d.Name)
+	if full, exists := abbreviations[name]; exists {
+		return full
+	}
+	return name
+}
+
+// Parse reads a ChordPro song.
+func Parse(r io.Reader) (*Song, error) {
+	song := &Song{}
+	scanner := bufio.NewScanner(r)
+
+	number := 0
+	verbatim := false
+	for scanner.Scan() {
+		number++
+		text := strings.TrimRight(scanner.Text(), "\r")
+
+		// Tabs and grids are not made of inline chords, keep them as they are.
+		if verbatim && !strings.HasPrefix(strings.TrimSpace(text), "{") {
+			song.Lines = append(song.Lines, Line{Kind: LyricsLine, Segments: []Segment{{Lyrics: text}}})
+			continue
+		}
+
+		line, err := parseLine(text, number)
+		if err != nil {
+			return nil, err
+		}
+		if line.Kind == DirectiveLine {
+			switch line.Directive.Canonical() {
+			case "start_of_tab", "start_of_grid":
+				verbatim = true
+			case "end_of_tab", "end_of_grid":
+				verbatim = false
+			}
+		}
+		song.Lines = append(song.Lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return song, nil
+}
+
+// ParseString is a shorthand for Parse(strings.NewReader(s)).
+func ParseString(s string) (*Song, error) {
+	return Parse(strings.NewReader(s))
+}
+
+func parseLine(text string, number int) (Line, error) {
+	trimmed := strings.TrimSpace(text)
+
+	switch {
+	case trimmed == "":
+		return Line{Kind: EmptyLine}, nil
+	case strings.HasPrefix(trimmed, "#"):
+		return Line{Kind: CommentLine, Comment: strings.TrimPrefix(trimmed, "#")}, nil
+	case strings.HasPrefix(trimmed, "{"):
+		directive, err := parseDirective(trimmed, number)
+		if err != nil {
+			return Line{}, err
+		}
+		return Line{Kind: DirectiveLine, Directive: directive}, nil
+	}
+
+	segments, err := parseSegments(text, number)
+	if err != nil {
+		return Line{}, err
+	}
+	return Line{Kind: LyricsLine, Segments: segments}, nil
+}
+
+func parseDirective(text string, number int) (Directive, error) {
+	if !strings.HasSuffix(text, "}") {
+		return Directive{}, &ParseError{Line: number, Msg: "unterminated directive"}
+	}
+	body := strings.TrimSpace(text[1 : len(text)-1])
+	if body == "" {
+		return Directive{}, &ParseError{Line: number, Msg: "empty directive"}
+	}
+
+	// Both "{title: x}" and "{title x}" are allowed, with spaces around the
+	// colon. The value can have colons: "{title: Intro: slow}".
+	separator := strings.Index(body, ":")
+	if separator == -1 || strings.ContainsAny(strings.TrimSpace(body[:separator]), " \t") {
+		separator = strings.IndexAny(body, " \t")
+	}
+	if separator == -1 {
+		return Directive{Name: body}, nil
+	}
+
+	return Directive{
+		Name:     strings.TrimSpace(body[:separator]),
+		Value:    strings.TrimSpace(body[separator+1:]),
+		HasValue: true,
+	}, nil
+}
+
+func parseSegments(text string, number int) ([]Segment, error) {
+	var segments []Segment
+	current := Segment{}
+
+	for len(text) > 0 {
+		open := strings.Index(text, "[")
+		if open == -1 {
+			current.Lyrics += text
+			break
+		}
+		current.Lyrics += text[:open]
+
+		end := strings.Index(text[open:], "]")
+		if end == -1 {
+			return nil, &ParseError{Line: number, Msg: "unterminated chord"}
+		}
+		chord := text[open+1 : open+end]
+		if strings.Contains(chord, "[") {
+			return nil, &ParseError{Line: number, Msg: "nested chord"}
+		}
+
+		if current.Chord != "" || current.Lyrics != "" {
+			segments = append(segments, current)
+		}
+		current = Segment{Chord: chord}
+		text = text[open+end+1:]
+	}
+
+	return append(segments, current), nil
+}
+
+// Meta returns the value of the first directive with the given canonical name,
+// e.g. song.Meta("title") or song.Meta("key").
+func (s *Song) Meta(name string) string {
+	for _, line := range s.Lines {
+		if line.Kind == DirectiveLine && line.Directive.Canonical() == name {
+			return line.Directive.Value
+		}
+	}
+	return ""
+}
+
+// Title returns the value of the {title} directive.
+func (s *Song) Title() string {
+	return s.Meta("title")
+}
+
+// Key returns the value of the {key} directive.
+func (s *Song) Key() string {
+	return s.Meta("key")
+}
+
+// Chords returns all inline chords, in order of appearance.
+func (s *Song) Chords() []string {
+	var chords []string
+	for _, line := range s.Lines {
+		for _, segment := range line.Segments {
+			if segment.Chord != "" {
+				chords = append(chords, segment.Chord)
+			}
+		}
+	}
+	return chords
+}
+
+// WriteTo writes the song in ChordPro format.
+func (s *Song) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, line := range s.Lines {
+		n, err := io.WriteString(w, line.String()+"\n")
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// String returns the song in ChordPro format.
+func (s *Song) String() string {
+	var builder strings.Builder
+	s.WriteTo(&builder)
+	return builder.String()
+}
+
+// String returns the line in ChordPro format.
+func (l Line) String() string {
+	switch l.Kind {
+	case DirectiveLine:
+		if l.Directive.HasValue {
+			return fmt.Sprintf("{%s: %s}", l.Directive.Name, l.Directive.Value)
+		}
+		return fmt.Sprintf("{%s}", l.Directive.Name)
+	case CommentLine:
+		return "#" + l.Comment
+	case LyricsLine:
+		var builder strings.Builder
+		for _, segment := range l.Segments {
+			if segment.Chord != "" {
+				builder.WriteString("[" + segment.Chord + "]")
+			}
+			builder.WriteString(segment.Lyrics)
+		}
+		return builder.String()
+	}
+	return ""
+}
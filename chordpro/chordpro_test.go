@@ -0,0 +1,124 @@
+package chordpro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const song = `{title: Amazing Grace}
+{key: G}
+# public domain
+
+{soc}
+A[G]mazing [G7]grace, how [C]sweet the [G]sound
+That [G]saved a [Em]wretch like [D]me[D7]
+{eoc}
+`
+
+func TestParse(t *testing.T) {
+	s, err := ParseString(song)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Amazing Grace", s.Title())
+	assert.Equal(t, "G", s.Key())
+	assert.Equal(t, []string{"G", "G7", "C", "G", "G", "Em", "D", "D7"}, s.Chords())
+
+	assert.Equal(t, CommentLine, s.Lines[2].Kind)
+	assert.Equal(t, EmptyLine, s.Lines[3].Kind)
+	assert.Equal(t, "start_of_chorus", s.Lines[4].Directive.Canonical())
+	assert.Equal(t, []Segment{
+		{Chord: "", Lyrics: "A"},
+		{Chord: "G", Lyrics: "mazing "},
+		{Chord: "G7", Lyrics: "grace, how "},
+		{Chord: "C", Lyrics: "sweet the "},
+		{Chord: "G", Lyrics: "sound"},
+	}, s.Lines[5].Segments)
+
+	t.Run("round trip", func(t *testing.T) {
+		assert.Equal(t, song, s.String())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := ParseString("{title: x}\nla [C la")
+		assert.EqualError(t, err, "chordpro: line 2: unterminated chord")
+
+		_, err = ParseString("{title: x")
+		assert.EqualError(t, err, "chordpro: line 1: unterminated directive")
+	})
+
+	t.Run("directives", func(t *testing.T) {
+		tests := []struct {
+			text     string
+			expected Directive
+		}{
+			{"{key: G}", Directive{Name: "key", Value: "G", HasValue: true}},
+			{"{key : G}", Directive{Name: "key", Value: "G", HasValue: true}},
+			{"{key:G}", Directive{Name: "key", Value: "G", HasValue: true}},
+			{"{key G}", Directive{Name: "key", Value: "G", HasValue: true}},
+			{"{title: Intro: slow}", Directive{Name: "title", Value: "Intro: slow", HasValue: true}},
+			{"{title Intro: slow}", Directive{Name: "title", Value: "Intro: slow", HasValue: true}},
+			{"{soc}", Directive{Name: "soc"}},
+		}
+		for _, test := range tests {
+			s, err := ParseString(test.text)
+			assert.NoError(t, err, test.text)
+			assert.Equal(t, test.expected, s.Lines[0].Directive, test.text)
+		}
+	})
+
+	t.Run("tabs are kept verbatim", func(t *testing.T) {
+		s, err := ParseString("{sot}\ne|--[0]--|\n{eot}")
+		assert.NoError(t, err)
+		assert.Empty(t, s.Chords())
+		assert.Equal(t, "{sot}\ne|--[0]--|\n{eot}\n", s.String())
+	})
+}
+
+func TestValidate(t *testing.T) {
+	s, err := ParseString("[C]la [Cfoo]la [H7]la\n[*Coda][N.C.][Bbm7b5/Ab]la")
+	assert.NoError(t, err)
+
+	problems := s.Validate()
+	assert.Equal(t, []Problem{
+		{Line: 1, Chord: "Cfoo", Reason: `has unknown chord type "foo"`},
+		{Line: 1, Chord: "H7", Reason: "is not a chord symbol"},
+	}, problems)
+}
+
+func TestTranspose(t *testing.T) {
+	t.Run("by interval", func(t *testing.T) {
+		s, _ := ParseString(song)
+		up, err := s.Transpose("2M")
+		assert.NoError(t, err)
+		assert.Equal(t, "A", up.Key())
+		assert.Equal(t, []string{"A", "A7", "D", "A", "A", "F#m", "E", "E7"}, up.Chords())
+
+		// The original is left untouched.
+		assert.Equal(t, "G", s.Key())
+	})
+
+	t.Run("keeps the spelling", func(t *testing.T) {
+		s, _ := ParseString("{key: Bb}\n[Bb][Eb/G][F7sus4][Gm7]")
+		down, _ := s.Transpose("-2M")
+		assert.Equal(t, "Ab", down.Key())
+		assert.Equal(t, []string{"Ab", "Db/F", "Eb7sus4", "Fm7"}, down.Chords())
+	})
+
+	t.Run("key-aware enharmonics", func(t *testing.T) {
+		s, _ := ParseString("[C][Am][F][G7]")
+		up, _ := s.Transpose("1A")
+		assert.Equal(t, []string{"Db", "Bbm", "Gb", "Ab7"}, up.Chords())
+
+		s, _ = ParseString("{key: Em}\n[Em][B7][C]")
+		up, _ = s.Transpose("1A")
+		assert.Equal(t, "Fm", up.Key())
+		assert.Equal(t, []string{"Fm", "C7", "Db"}, up.Chords())
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		s, _ := ParseString("[C]")
+		_, err := s.Transpose("foo")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package chordpro
+
+import (
+	"fmt"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+)
+
+// Transpose returns a copy of the song with every chord and the {key} directive
+// moved by the given interval ("2M", "-3m", "P4"...).
+//
+// The target key is kept readable: if the transposed key would have more than
+// 6 sharps or flats, its enharmonic equivalent is used instead (C up an
+// augmented unison gives Db, not C#). Chords are then spelled as usual in the
+// target key. The key is taken from the {key} directive, or from the first chord
+// when there is none.
+//
+// Chords that are not valid symbols are left unchanged.
+func (s *Song) Transpose(interval string) (*Song, error) {
+	ivl := pitchinterval.Parse(interval)
+	if ivl.Empty {
+		return nil, fmt.Errorf("chordpro: invalid interval %q", interval)
+	}
+
+	spelling := chord.Spelling{}
+	if key := s.tonality(); !key.Empty {
+		key = chord.Transpose(key, ivl, chord.Spelling{})
+		if fifths, ok := chord.KeyFifths(key.Symbol); ok && fifths > 6 {
+			key = chord.Respell(key, chord.Spelling{Accidentals: chord.Flats})
+		} else if ok && fifths < -6 {
+			key = chord.Respell(key, chord.Spelling{Accidentals: chord.Sharps})
+		}
+		spelling.Key = key.Symbol
+	}
+
+	transposed := &Song{Lines: make([]Line, len(s.Lines))}
+	for i, line := range s.Lines {
+		transposed.Lines[i] = line
+
+		if line.Kind == DirectiveLine && line.Directive.Canonical() == "key" {
+			transposed.Lines[i].Directive.Value = transposeSymbol(line.Directive.Value, ivl, spelling)
+		}
+
+		if line.Segments != nil {
+			segments := make([]Segment, len(line.Segments))
+			for j, segment := range line.Segments {
+				segments[j] = segment
+				if isChord(segment.Chord) {
+					segments[j].Chord = transposeSymbol(segment.Chord, ivl, spelling)
+				}
+			}
+			transposed.Lines[i].Segments = segments
+		}
+	}
+
+	return transposed, nil
+}
+
+// tonality returns the key of the song as a chord ("Am"), or an empty chord if it is unknown.
+func (s *Song) tonality() chord.Chord {
+	if key := chord.Get(s.Key()); !key.Empty {
+		return key
+	}
+	for _, symbol := range s.Chords() {
+		if c := chord.Get(symbol); isChord(symbol) && c.Known() {
+			return c
+		}
+	}
+	return chord.NoChord
+}
+
+func transposeSymbol(symbol string, ivl pitchinterval.Interval, spelling chord.Spelling) string {
+	c := chord.Get(symbol)
+	if c.Empty {
+		return symbol
+	}
+	return chord.Transpose(c, ivl, spelling).Symbol
+}
@@ -0,0 +1,61 @@
+package chordpro
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+)
+
+// Problem is an inline chord that is not a valid chord symbol.
+type Problem struct {
+	Line   int
+	Chord  string
+	Reason string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("line %d: [%s] %s", p.Line, p.Chord, p.Reason)
+}
+
+// Validate checks every inline chord against the chord type dictionary.
+// Annotations ("[*Coda]") and "no chord" marks ("[N.C.]") are not chords and are skipped.
+//
+// Returns the list of problems, in order of appearance, or an empty list if all chords are known.
+func (s *Song) Validate() []Problem {
+	problems := make([]Problem, 0)
+	for i, line := range s.Lines {
+		for _, segment := range line.Segments {
+			if !isChord(segment.Chord) {
+				continue
+			}
+
+			c := chord.Get(segment.Chord)
+			if c.Empty {
+				problems = append(problems, Problem{
+					Line:   i + 1,
+					Chord:  segment.Chord,
+					Reason: "is not a chord symbol",
+				})
+			} else if !c.Known() {
+				problems = append(problems, Problem{
+					Line:   i + 1,
+					Chord:  segment.Chord,
+					Reason: fmt.Sprintf("has unknown chord type %q", c.Type),
+				})
+			}
+		}
+	}
+	return problems
+}
+
+func isChord(symbol string) bool {
+	if symbol == "" || strings.HasPrefix(symbol, "*") {
+		return false
+	}
+	switch strings.ToUpper(strings.TrimSpace(symbol)) {
+	case "N.C.", "N.C", "NC":
+		return false
+	}
+	return true
+}
@@ -13,19 +13,34 @@ import (
 type ChordQuality string
 
 const (
-	Major      ChordQuality = "Major"
-	Minor      ChordQuality = "Minor"
-	Augmented  ChordQuality = "Augmented"
-	Diminished ChordQuality = "Diminished"
-	Unknown    ChordQuality = "Unknown"
+	Major          ChordQuality = "Major"
+	Minor          ChordQuality = "Minor"
+	Dominant       ChordQuality = "Dominant"
+	Augmented      ChordQuality = "Augmented"
+	Diminished     ChordQuality = "Diminished"
+	HalfDiminished ChordQuality = "HalfDiminished"
+	Suspended      ChordQuality = "Suspended"
+	Power          ChordQuality = "Power"
+	Quartal        ChordQuality = "Quartal"
+	Unknown        ChordQuality = "Unknown"
 )
 
+// ChordType is an entry of the dictionary. Tags are free-form labels of the
+// chord type; each built-in chord type has one size tag, its first, from its
+// number of notes ("power" for 2, "triad" for 3, "tetrad" for 4, "extended" for
+// more), and tags of structure ("added", "sus", "altered", "no5", "quartal")
+// and style ("pop", "jazz").
+//
+// Optional are the intervals a voicing of the chord type may leave out, such as
+// the fifth of seventh chords, see the omission policy of detection.
 type ChordType struct {
 	pcset.Pcset
 	Name      string
 	Quality   ChordQuality
 	Aliases   []string
 	Intervals []string
+	Tags      []string
+	Optional  []string
 }
 
 var NoChordType = ChordType{
@@ -42,13 +57,27 @@ var index map[string]ChordType
 func init() {
 	dictionary = make([]ChordType, 0)
 	index = make(map[string]ChordType)
+	addDefaults()
+}
 
+// addDefaults adds the chords of data.go.
+func addDefaults() {
 	for _, data := range chords {
 		if len(data) >= 3 {
-			intervals := strings.Split(data[0], " ")
-			fullName := data[1]
-			aliases := strings.Split(data[2], " ")
-			Add(intervals, aliases, fullName)
+			entry := Entry{
+				Intervals: strings.Split(data[0], " "),
+				Name:      data[1],
+				Aliases:   strings.Split(data[2], " "),
+			}
+			if len(data) >= 4 {
+				entry.Tags = strings.Fields(data[3])
+			}
+			if len(data) >= 5 {
+				entry.Optional = strings.Fields(data[4])
+			}
+			if err := AddEntry(entry); err != nil {
+				panic(err)
+			}
 		}
 	}
 
@@ -57,6 +86,23 @@ func init() {
 	})
 }
 
+// OptionalSet returns the pitch classes of the optional intervals of the chord
+// type, above its root.
+func (c ChordType) OptionalSet() pcset.Set {
+	set, _ := pcset.IntervalsToSet(c.Optional)
+	return set
+}
+
+// HasTag returns true if the chord type has the tag.
+func (c ChordType) HasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
 // Get retrieves a chord type by name, alias, chroma, or setNum.
 func Get(typeName string) ChordType {
 	if chord, exists := index[typeName]; exists {
@@ -87,6 +133,22 @@ func Symbols() []string {
 	return symbols
 }
 
+// Tags returns the tags of the chord types, in alphabetical order.
+func Tags() []string {
+	seen := make(map[string]bool)
+	tags := make([]string, 0)
+	for _, chord := range dictionary {
+		for _, tag := range chord.Tags {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
 // Keys returns all the keys used to reference chord types
 func Keys() []string {
 	var keys []string
@@ -108,17 +170,25 @@ func RemoveAll() {
 }
 
 // Add adds a chord to the dictionary.
-func Add(intervals []string, aliases []string, fullName string) {
-	quality := getQuality(intervals)
-
-	chord := ChordType{
-		Pcset:     pcset.IntervalsToPcset(intervals),
-		Name:      fullName,
-		Quality:   quality,
-		Intervals: intervals,
-		Aliases:   aliases,
+// Returns an error, and adds nothing, if one of the intervals is invalid.
+func Add(intervals []string, aliases []string, fullName string) error {
+	return AddEntry(Entry{Intervals: intervals, Name: fullName, Aliases: aliases})
+}
+
+// AddEntry adds a chord to the dictionary, like Add, with a quality and tags.
+// The quality is computed from the intervals when it is empty.
+// Returns an error, and adds nothing, if there are no intervals, or one of the
+// intervals or the quality is invalid.
+func AddEntry(entry Entry) error {
+	chord, err := entry.chordType()
+	if err != nil {
+		return err
 	}
+	add(chord)
+	return nil
+}
 
+func add(chord ChordType) {
 	dictionary = append(dictionary, chord)
 	if chord.Name != "" {
 		index[chord.Name] = chord
@@ -135,18 +205,7 @@ func AddAlias(chord ChordType, alias string) {
 	index[alias] = chord
 }
 
-func getQuality(intervals []string) ChordQuality {
-	for _, interval := range intervals {
-		switch interval {
-		case "5A":
-			return Augmented
-		case "3M":
-			return Major
-		case "5d":
-			return Diminished
-		case "3m":
-			return Minor
-		}
-	}
-	return Unknown
+// Qualities returns the qualities a chord type can have.
+func Qualities() []ChordQuality {
+	return []ChordQuality{Major, Minor, Dominant, Augmented, Diminished, HalfDiminished, Suspended, Power, Quartal, Unknown}
 }
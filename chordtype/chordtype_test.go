@@ -44,20 +44,52 @@ func TestGet(t *testing.T) {
 		Quality:   Major,
 		Intervals: []string{"1P", "3M", "5P"},
 		Aliases:   []string{"M", "^", "", "maj"},
+		Tags:      []string{"triad", "pop"},
 	}
 	assert.Equal(t, expected, major, "Should return correct major chord")
 }
 
 func TestAdd(t *testing.T) {
-	Add([]string{"1P", "5P"}, []string{"q"}, "")
+	assert.NoError(t, Add([]string{"1P", "5P"}, []string{"q"}, ""))
 	quinta := Get("q")
 	assert.Equal(t, "100000010000", quinta.Chroma, "Should have correct chroma")
 
-	Add([]string{"1P", "5P"}, []string{"q"}, "quinta")
+	assert.NoError(t, Add([]string{"1P", "5P"}, []string{"q"}, "quinta"))
 	quintaByName := Get("quinta")
 	assert.Equal(t, Get("q"), quintaByName, "Should get same chord by name")
 }
 
+func TestAddInvalid(t *testing.T) {
+	count := len(All())
+	err := Add([]string{"1P", "3M", "5M"}, []string{"bad"}, "bad chord")
+	assert.ErrorIs(t, err, pitchinterval.ErrMismatch)
+	assert.EqualError(t, err, `chordtype: "bad chord": pitchinterval: "5M": quality doesn't match the interval number`)
+	assert.Len(t, All(), count, "Should not add the chord")
+	assert.True(t, Get("bad").Empty)
+}
+
+func TestTags(t *testing.T) {
+	assert.Equal(t, []string{"tetrad", "jazz", "pop"}, Get("maj7").Tags)
+	assert.True(t, Get("7#9").HasTag("altered"))
+	assert.True(t, Get("sus4").HasTag("sus"))
+	assert.False(t, Get("M").HasTag("jazz"))
+	assert.False(t, NoChordType.HasTag("triad"))
+	assert.Contains(t, Tags(), "quartal")
+	assert.True(t, Get("alt7").HasTag("tetrad"))
+	assert.True(t, Get("69").HasTag("extended"))
+}
+
+func TestOptional(t *testing.T) {
+	assert.Equal(t, []string{"5P"}, Get("maj7").Optional)
+	assert.Equal(t, []string{"5P", "9M"}, Get("13").Optional)
+	assert.Empty(t, Get("M").Optional)
+	assert.Equal(t, "000000010000", Get("m7").OptionalSet().Chroma())
+
+	err := AddEntry(Entry{Intervals: []string{"1P", "3M", "5P"}, Name: "odd", Optional: []string{"7m"}})
+	assert.ErrorIs(t, err, ErrOptional)
+	assert.EqualError(t, err, `chordtype: "odd": optional interval not in the chord "7m"`)
+}
+
 func TestRemoveAll(t *testing.T) {
 	RemoveAll()
 	assert.Empty(t, All(), "Should have no chords after RemoveAll")
@@ -86,6 +118,27 @@ func TestChordData(t *testing.T) {
 		}
 	})
 
+	t.Run("all chords must have tags", func(t *testing.T) {
+		for _, data := range chords {
+			assert.True(t, len(data) >= 4 && strings.TrimSpace(data[3]) != "", "Chord should have tags: %v", data)
+		}
+	})
+
+	t.Run("chords have one size tag, from their number of notes", func(t *testing.T) {
+		sizes := []string{"power", "triad", "tetrad", "extended"}
+		for _, data := range chords {
+			size := len(strings.Fields(data[0]))
+			if size > 5 {
+				size = 5
+			}
+			tags := strings.Fields(data[3])
+			assert.Equal(t, sizes[size-2], tags[0], "Chord should be tagged by size first: %v", data)
+			for _, tag := range tags[1:] {
+				assert.NotContains(t, sizes, tag, "Chord should have one size tag: %v", data)
+			}
+		}
+	})
+
 	t.Run("intervals should be in ascending order", func(t *testing.T) {
 		for _, data := range chords {
 			intervalList := data[0]
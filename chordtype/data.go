@@ -2,138 +2,145 @@
 // Reference: https://github.com/tonaljs/tonal/tree/main/packages/chord-type/data.ts
 package chordtype
 
-// Format: ["intervals", "full name", "abrv1 abrv2"].
+// Format: ["intervals", "full name", "abrv1 abrv2", "tag1 tag2", "optional intervals"].
 var chords = [][]string{
 	// ==Major==
-	{"1P 3M 5P", "major", "M ^  maj"},
-	{"1P 3M 5P 7M", "major seventh", "maj7 Δ ma7 M7 Maj7 ^7"},
-	{"1P 3M 5P 7M 9M", "major ninth", "maj9 Δ9 ^9"},
-	{"1P 3M 5P 7M 9M 13M", "major thirteenth", "maj13 Maj13 ^13"},
-	{"1P 3M 5P 6M", "sixth", "6 add6 add13 M6"},
-	{"1P 3M 5P 6M 9M", "sixth added ninth", "6add9 6/9 69 M69"},
-	{"1P 3M 6m 7M", "major seventh flat sixth", "M7b6 ^7b6"},
+	{"1P 3M 5P", "major", "M ^  maj", "triad pop"},
+	{"1P 3M 5P 7M", "major seventh", "maj7 Δ ma7 M7 Maj7 ^7 Δ7", "tetrad jazz pop", "5P"},
+	{"1P 3M 5P 7M 9M", "major ninth", "maj9 Δ9 ^9", "extended jazz pop", "5P"},
+	{"1P 3M 5P 7M 9M 13M", "major thirteenth", "maj13 Maj13 ^13 Δ13", "extended jazz", "5P 9M"},
+	{"1P 3M 5P 6M", "sixth", "6 add6 add13 M6", "tetrad added jazz pop"},
+	{"1P 3M 5P 6M 9M", "sixth added ninth", "6add9 6/9 69 M69", "extended added jazz pop"},
+	{"1P 3M 6m 7M", "major seventh flat sixth", "M7b6 ^7b6", "tetrad no5 jazz"},
 	{
 		"1P 3M 5P 7M 11A",
 		"major seventh sharp eleventh",
-		"maj#4 Δ#4 Δ#11 M7#11 ^7#11 maj7#11",
+		"maj#4 Δ#4 Δ#11 M7#11 ^7#11 maj7#11 maj7(#11)",
+		"extended jazz",
+		"5P",
 	},
 	// ==Minor==
 	// '''Normal'''
-	{"1P 3m 5P", "minor", "m min -"},
-	{"1P 3m 5P 7m", "minor seventh", "m7 min7 mi7 -7"},
+	{"1P 3m 5P", "minor", "m min -", "triad pop"},
+	{"1P 3m 5P 7m", "minor seventh", "m7 min7 mi7 -7", "tetrad jazz pop", "5P"},
 	{
 		"1P 3m 5P 7M",
 		"minor/major seventh",
-		"m/ma7 m/maj7 mM7 mMaj7 m/M7 -Δ7 mΔ -^7 -maj7",
+		"m/ma7 m/maj7 mM7 mMaj7 m/M7 -Δ7 mΔ -^7 -maj7 -(maj7)",
+		"tetrad jazz pop",
+		"5P",
 	},
-	{"1P 3m 5P 6M", "minor sixth", "m6 -6"},
-	{"1P 3m 5P 7m 9M", "minor ninth", "m9 -9"},
-	{"1P 3m 5P 7M 9M", "minor/major ninth", "mM9 mMaj9 -^9"},
-	{"1P 3m 5P 7m 9M 11P", "minor eleventh", "m11 -11"},
-	{"1P 3m 5P 7m 9M 13M", "minor thirteenth", "m13 -13"},
+	{"1P 3m 5P 6M", "minor sixth", "m6 -6", "tetrad added jazz pop"},
+	{"1P 3m 5P 7m 9M", "minor ninth", "m9 -9", "extended jazz pop", "5P"},
+	{"1P 3m 5P 7M 9M", "minor/major ninth", "mM9 mMaj9 -^9", "extended jazz", "5P"},
+	{"1P 3m 5P 7m 9M 11P", "minor eleventh", "m11 -11", "extended jazz", "5P 9M"},
+	{"1P 3m 5P 7m 9M 13M", "minor thirteenth", "m13 -13", "extended jazz", "5P 9M"},
 	// '''Diminished'''
-	{"1P 3m 5d", "diminished", "dim ° o"},
-	{"1P 3m 5d 7d", "diminished seventh", "dim7 °7 o7"},
-	{"1P 3m 5d 7m", "half-diminished", "m7b5 ø -7b5 h7 h"},
+	{"1P 3m 5d", "diminished", "dim ° o", "triad pop"},
+	{"1P 3m 5d 7d", "diminished seventh", "dim7 °7 o7", "tetrad jazz pop"},
+	{"1P 3m 5d 7m", "half-diminished", "m7b5 ø -7b5 h7 h ø7 -7(b5)", "tetrad jazz pop"},
 	// ==Dominant/Seventh==
 	// '''Normal'''
-	{"1P 3M 5P 7m", "dominant seventh", "7 dom"},
-	{"1P 3M 5P 7m 9M", "dominant ninth", "9"},
-	{"1P 3M 5P 7m 9M 13M", "dominant thirteenth", "13"},
-	{"1P 3M 5P 7m 11A", "lydian dominant seventh", "7#11 7#4"},
+	{"1P 3M 5P 7m", "dominant seventh", "7 dom", "tetrad jazz pop", "5P"},
+	{"1P 3M 5P 7m 9M", "dominant ninth", "9", "extended jazz pop", "5P"},
+	{"1P 3M 5P 7m 9M 13M", "dominant thirteenth", "13", "extended jazz", "5P 9M"},
+	{"1P 3M 5P 7m 11A", "lydian dominant seventh", "7#11 7#4 7(#11)", "extended altered jazz", "5P"},
 	// '''Altered'''
-	{"1P 3M 5P 7m 9m", "dominant flat ninth", "7b9"},
-	{"1P 3M 5P 7m 9A", "dominant sharp ninth", "7#9"},
-	{"1P 3M 7m 9m", "altered", "alt7"},
+	{"1P 3M 5P 7m 9m", "dominant flat ninth", "7b9 7(b9)", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9A", "dominant sharp ninth", "7#9 7(#9)", "extended altered jazz", "5P"},
+	{"1P 3M 7m 9m", "altered", "alt7", "tetrad altered no5 jazz"},
 	// '''Suspended'''
-	{"1P 4P 5P", "suspended fourth", "sus4 sus"},
-	{"1P 2M 5P", "suspended second", "sus2"},
-	{"1P 4P 5P 7m", "suspended fourth seventh", "7sus4 7sus"},
-	{"1P 5P 7m 9M 11P", "eleventh", "11"},
+	{"1P 4P 5P", "suspended fourth", "sus4 sus", "triad sus pop"},
+	{"1P 2M 5P", "suspended second", "sus2", "triad sus pop"},
+	{"1P 4P 5P 7m", "suspended fourth seventh", "7sus4 7sus", "tetrad sus jazz pop"},
+	{"1P 5P 7m 9M 11P", "eleventh", "11", "extended sus jazz", "9M"},
 	{
 		"1P 4P 5P 7m 9m",
 		"suspended fourth flat ninth",
 		"b9sus phryg 7b9sus 7b9sus4",
+		"extended sus altered jazz",
 	},
 	// ==Other==
-	{"1P 5P", "fifth", "5"},
-	{"1P 3M 5A", "augmented", "aug + +5 ^#5"},
-	{"1P 3m 5A", "minor augmented", "m#5 -#5 m+"},
-	{"1P 3M 5A 7M", "augmented seventh", "maj7#5 maj7+5 +maj7 ^7#5"},
+	{"1P 5P", "fifth", "5", "power pop"},
+	{"1P 3M 5A", "augmented", "aug + +5 ^#5", "triad pop"},
+	{"1P 3m 5A", "minor augmented", "m#5 -#5 m+", "triad"},
+	{"1P 3M 5A 7M", "augmented seventh", "maj7#5 maj7+5 +maj7 ^7#5", "tetrad altered jazz"},
 	{
 		"1P 3M 5P 7M 9M 11A",
 		"major sharp eleventh (lydian)",
 		"maj9#11 Δ9#11 ^9#11",
+		"extended jazz",
+		"5P",
 	},
 	// ==Legacy==
-	{"1P 2M 4P 5P", "", "sus24 sus4add9"},
-	{"1P 3M 5A 7M 9M", "", "maj9#5 Maj9#5"},
-	{"1P 3M 5A 7m", "", "7#5 +7 7+ 7aug aug7"},
-	{"1P 3M 5A 7m 9A", "", "7#5#9 7#9#5 7alt"},
-	{"1P 3M 5A 7m 9M", "", "9#5 9+"},
-	{"1P 3M 5A 7m 9M 11A", "", "9#5#11"},
-	{"1P 3M 5A 7m 9m", "", "7#5b9 7b9#5"},
-	{"1P 3M 5A 7m 9m 11A", "", "7#5b9#11"},
-	{"1P 3M 5A 9A", "", "+add#9"},
-	{"1P 3M 5A 9M", "", "M#5add9 +add9"},
-	{"1P 3M 5P 6M 11A", "", "M6#11 M6b5 6#11 6b5"},
-	{"1P 3M 5P 6M 7M 9M", "", "M7add13"},
-	{"1P 3M 5P 6M 9M 11A", "", "69#11"},
-	{"1P 3m 5P 6M 9M", "", "m69 -69"},
-	{"1P 3M 5P 6m 7m", "", "7b6"},
-	{"1P 3M 5P 7M 9A 11A", "", "maj7#9#11"},
-	{"1P 3M 5P 7M 9M 11A 13M", "", "M13#11 maj13#11 M13+4 M13#4"},
-	{"1P 3M 5P 7M 9m", "", "M7b9"},
-	{"1P 3M 5P 7m 11A 13m", "", "7#11b13 7b5b13"},
-	{"1P 3M 5P 7m 13M", "", "7add6 67 7add13"},
-	{"1P 3M 5P 7m 9A 11A", "", "7#9#11 7b5#9 7#9b5"},
-	{"1P 3M 5P 7m 9A 11A 13M", "", "13#9#11"},
-	{"1P 3M 5P 7m 9A 11A 13m", "", "7#9#11b13"},
-	{"1P 3M 5P 7m 9A 13M", "", "13#9"},
-	{"1P 3M 5P 7m 9A 13m", "", "7#9b13"},
-	{"1P 3M 5P 7m 9M 11A", "", "9#11 9+4 9#4"},
-	{"1P 3M 5P 7m 9M 11A 13M", "", "13#11 13+4 13#4"},
-	{"1P 3M 5P 7m 9M 11A 13m", "", "9#11b13 9b5b13"},
-	{"1P 3M 5P 7m 9m 11A", "", "7b9#11 7b5b9 7b9b5"},
-	{"1P 3M 5P 7m 9m 11A 13M", "", "13b9#11"},
-	{"1P 3M 5P 7m 9m 11A 13m", "", "7b9b13#11 7b9#11b13 7b5b9b13"},
-	{"1P 3M 5P 7m 9m 13M", "", "13b9"},
-	{"1P 3M 5P 7m 9m 13m", "", "7b9b13"},
-	{"1P 3M 5P 7m 9m 9A", "", "7b9#9"},
-	{"1P 3M 5P 9M", "", "Madd9 2 add9 add2"},
-	{"1P 3M 5P 9m", "", "Maddb9"},
-	{"1P 3M 5d", "", "Mb5"},
-	{"1P 3M 5d 6M 7m 9M", "", "13b5"},
-	{"1P 3M 5d 7M", "", "M7b5"},
-	{"1P 3M 5d 7M 9M", "", "M9b5"},
-	{"1P 3M 5d 7m", "", "7b5"},
-	{"1P 3M 5d 7m 9M", "", "9b5"},
-	{"1P 3M 7m", "", "7no5"},
-	{"1P 3M 7m 13m", "", "7b13"},
-	{"1P 3M 7m 9M", "", "9no5"},
-	{"1P 3M 7m 9M 13M", "", "13no5"},
-	{"1P 3M 7m 9M 13m", "", "9b13"},
-	{"1P 3m 4P 5P", "", "madd4"},
-	{"1P 3m 5P 6m 7M", "", "mMaj7b6"},
-	{"1P 3m 5P 6m 7M 9M", "", "mMaj9b6"},
-	{"1P 3m 5P 7m 11P", "", "m7add11 m7add4"},
-	{"1P 3m 5P 9M", "", "madd9"},
-	{"1P 3m 5d 6M 7M", "", "o7M7"},
-	{"1P 3m 5d 7M", "", "oM7"},
-	{"1P 3m 6m 7M", "", "mb6M7"},
-	{"1P 3m 6m 7m", "", "m7#5"},
-	{"1P 3m 6m 7m 9M", "", "m9#5"},
-	{"1P 3m 5A 7m 9M 11P", "", "m11A"},
-	{"1P 3m 6m 9m", "", "mb6b9"},
-	{"1P 2M 3m 5d 7m", "", "m9b5"},
-	{"1P 4P 5A 7M", "", "M7#5sus4"},
-	{"1P 4P 5A 7M 9M", "", "M9#5sus4"},
-	{"1P 4P 5A 7m", "", "7#5sus4"},
-	{"1P 4P 5P 7M", "", "M7sus4"},
-	{"1P 4P 5P 7M 9M", "", "M9sus4"},
-	{"1P 4P 5P 7m 9M", "", "9sus4 9sus"},
-	{"1P 4P 5P 7m 9M 13M", "", "13sus4 13sus"},
-	{"1P 4P 5P 7m 9m 13m", "", "7sus4b9b13 7b9b13sus4"},
-	{"1P 4P 7m 10m", "", "4 quartal"},
-	{"1P 5P 7m 9m 11P", "", "11b9"},
+	{"1P 2M 4P 5P", "", "sus24 sus4add9", "tetrad sus"},
+	{"1P 3M 5A 7M 9M", "", "maj9#5 Maj9#5", "extended altered jazz"},
+	{"1P 3M 5A 7m", "", "7#5 +7 7+ 7aug aug7", "tetrad altered jazz"},
+	{"1P 3M 5A 7m 9A", "", "7#5#9 7#9#5 7alt", "extended altered jazz"},
+	{"1P 3M 5A 7m 9M", "", "9#5 9+", "extended altered jazz"},
+	{"1P 3M 5A 7m 9M 11A", "", "9#5#11", "extended altered jazz"},
+	{"1P 3M 5A 7m 9m", "", "7#5b9 7b9#5", "extended altered jazz"},
+	{"1P 3M 5A 7m 9m 11A", "", "7#5b9#11", "extended altered jazz"},
+	{"1P 3M 5A 9A", "", "+add#9", "tetrad added altered"},
+	{"1P 3M 5A 9M", "", "M#5add9 +add9", "tetrad added"},
+	{"1P 3M 5P 6M 11A", "", "M6#11 M6b5 6#11 6b5", "extended added jazz"},
+	{"1P 3M 5P 6M 7M 9M", "", "M7add13", "extended jazz", "5P"},
+	{"1P 3M 5P 6M 9M 11A", "", "69#11", "extended added jazz"},
+	{"1P 3m 5P 6M 9M", "", "m69 -69", "extended added jazz"},
+	{"1P 3M 5P 6m 7m", "", "7b6", "extended jazz", "5P"},
+	{"1P 3M 5P 7M 9A 11A", "", "maj7#9#11", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7M 9M 11A 13M", "", "M13#11 maj13#11 M13+4 M13#4", "extended jazz", "5P 9M"},
+	{"1P 3M 5P 7M 9m", "", "M7b9", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 11A 13m", "", "7#11b13 7b5b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 13M", "", "7add6 67 7add13", "extended jazz", "5P"},
+	{"1P 3M 5P 7m 9A 11A", "", "7#9#11 7b5#9 7#9b5", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9A 11A 13M", "", "13#9#11", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9A 11A 13m", "", "7#9#11b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9A 13M", "", "13#9", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9A 13m", "", "7#9b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9M 11A", "", "9#11 9+4 9#4", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9M 11A 13M", "", "13#11 13+4 13#4", "extended altered jazz", "5P 9M"},
+	{"1P 3M 5P 7m 9M 11A 13m", "", "9#11b13 9b5b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 11A", "", "7b9#11 7b5b9 7b9b5", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 11A 13M", "", "13b9#11", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 11A 13m", "", "7b9b13#11 7b9#11b13 7b5b9b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 13M", "", "13b9", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 13m", "", "7b9b13", "extended altered jazz", "5P"},
+	{"1P 3M 5P 7m 9m 9A", "", "7b9#9", "extended altered jazz", "5P"},
+	{"1P 3M 5P 9M", "", "Madd9 2 add9 add2", "tetrad added pop"},
+	{"1P 3M 5P 9m", "", "Maddb9", "tetrad added altered"},
+	{"1P 3M 5d", "", "Mb5", "triad altered"},
+	{"1P 3M 5d 6M 7m 9M", "", "13b5", "extended altered jazz"},
+	{"1P 3M 5d 7M", "", "M7b5", "tetrad altered jazz"},
+	{"1P 3M 5d 7M 9M", "", "M9b5", "extended altered jazz"},
+	{"1P 3M 5d 7m", "", "7b5", "tetrad altered jazz"},
+	{"1P 3M 5d 7m 9M", "", "9b5", "extended altered jazz"},
+	{"1P 3M 7m", "", "7no5", "triad no5 jazz"},
+	{"1P 3M 7m 13m", "", "7b13 7(b13)", "tetrad altered no5 jazz"},
+	{"1P 3M 7m 9M", "", "9no5", "tetrad no5 jazz"},
+	{"1P 3M 7m 9M 13M", "", "13no5", "extended no5 jazz", "9M"},
+	{"1P 3M 7m 9M 13m", "", "9b13", "extended altered no5 jazz"},
+	{"1P 3m 4P 5P", "", "madd4", "tetrad added"},
+	{"1P 3m 5P 6m 7M", "", "mMaj7b6", "extended jazz", "5P"},
+	{"1P 3m 5P 6m 7M 9M", "", "mMaj9b6", "extended jazz", "5P"},
+	{"1P 3m 5P 7m 11P", "", "m7add11 m7add4", "extended jazz", "5P"},
+	{"1P 3m 5P 9M", "", "madd9", "tetrad added pop"},
+	{"1P 3m 5d 6M 7M", "", "o7M7", "extended jazz"},
+	{"1P 3m 5d 7M", "", "oM7", "tetrad jazz"},
+	{"1P 3m 6m 7M", "", "mb6M7", "tetrad no5 jazz"},
+	{"1P 3m 6m 7m", "", "m7#5", "tetrad no5 jazz"},
+	{"1P 3m 6m 7m 9M", "", "m9#5", "extended no5 jazz"},
+	{"1P 3m 5A 7m 9M 11P", "", "m11A", "extended altered jazz", "9M"},
+	{"1P 3m 6m 9m", "", "mb6b9", "tetrad added altered jazz"},
+	{"1P 2M 3m 5d 7m", "", "m9b5", "extended jazz"},
+	{"1P 4P 5A 7M", "", "M7#5sus4", "tetrad sus altered jazz"},
+	{"1P 4P 5A 7M 9M", "", "M9#5sus4", "extended sus altered jazz"},
+	{"1P 4P 5A 7m", "", "7#5sus4", "tetrad sus altered jazz"},
+	{"1P 4P 5P 7M", "", "M7sus4", "tetrad sus jazz"},
+	{"1P 4P 5P 7M 9M", "", "M9sus4", "extended sus jazz"},
+	{"1P 4P 5P 7m 9M", "", "9sus4 9sus", "extended sus jazz"},
+	{"1P 4P 5P 7m 9M 13M", "", "13sus4 13sus", "extended sus jazz", "9M"},
+	{"1P 4P 5P 7m 9m 13m", "", "7sus4b9b13 7b9b13sus4", "extended sus altered jazz"},
+	{"1P 4P 7m 10m", "", "4 quartal", "tetrad no5 quartal"},
+	{"1P 5P 7m 9m 11P", "", "11b9", "extended sus altered jazz"},
 }
@@ -0,0 +1,310 @@
+package chordtype
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is a chord type as written in a dictionary file.
+//
+// Intervals are required ("1P 3M 5P"). Name is the full name, and Aliases the
+// symbols of the chord type, the first one being the one detection results use.
+// Quality is one of the values of Qualities, computed from the intervals when it
+// is empty. Tags are free-form labels. Optional are intervals voicings may leave
+// out, which must be intervals of the chord type.
+//
+// A JSON file is an array of objects, and a YAML file a list of mappings, with
+// the keys "intervals", "name", "aliases", "quality", "tags" and "optional":
+//
+//	[{"intervals": ["1P", "3M", "5P"], "name": "major", "aliases": ["M", "maj"], "tags": ["triad"]}]
+//
+// A CSV file has a header with the same column names, in any order; lists are
+// separated by spaces:
+//
+//	intervals,name,aliases,quality,tags,optional
+//	1P 3M 5P 7M,major seventh,maj7 Δ,Major,tetrad,5P
+type Entry struct {
+	Intervals []string     `json:"intervals" yaml:"intervals"`
+	Name      string       `json:"name,omitempty" yaml:"name,omitempty"`
+	Aliases   []string     `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Quality   ChordQuality `json:"quality,omitempty" yaml:"quality,omitempty"`
+	Tags      []string     `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Optional  []string     `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+// Format is the format of a dictionary file.
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+	CSV  Format = "csv"
+)
+
+var (
+	ErrFormat      = errors.New("chordtype: unknown dictionary format")
+	ErrQuality     = errors.New("unknown chord quality")
+	ErrColumn      = errors.New("unknown column")
+	ErrOptional    = errors.New("optional interval not in the chord")
+	ErrNoIntervals = errors.New("no intervals")
+)
+
+var csvColumns = []string{"intervals", "name", "aliases", "quality", "tags", "optional"}
+
+// FormatOf returns the format of a file from its extension: ".json", ".yaml" or
+// ".yml", ".csv".
+func FormatOf(name string) (Format, bool) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return JSON, true
+	case ".yaml", ".yml":
+		return YAML, true
+	case ".csv":
+		return CSV, true
+	}
+	return "", false
+}
+
+// Read decodes the entries of a dictionary file.
+func Read(r io.Reader, format Format) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	switch format {
+	case JSON:
+		if err := json.NewDecoder(r).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("chordtype: %w", err)
+		}
+	case YAML:
+		if err := yaml.NewDecoder(r).Decode(&entries); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("chordtype: %w", err)
+		}
+	case CSV:
+		return readCSV(r)
+	default:
+		return nil, ErrFormat
+	}
+	return entries, nil
+}
+
+func readCSV(r io.Reader) ([]Entry, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("chordtype: %w", err)
+	}
+	entries := make([]Entry, 0)
+	if len(records) == 0 {
+		return entries, nil
+	}
+
+	header := records[0]
+	for _, column := range header {
+		if !isColumn(column) {
+			return nil, fmt.Errorf("chordtype: %w %q", ErrColumn, column)
+		}
+	}
+	for _, record := range records[1:] {
+		var entry Entry
+		for i, value := range record {
+			switch header[i] {
+			case "intervals":
+				entry.Intervals = splitList(value)
+			case "name":
+				entry.Name = value
+			case "aliases":
+				entry.Aliases = splitList(value)
+			case "quality":
+				entry.Quality = ChordQuality(value)
+			case "tags":
+				entry.Tags = splitList(value)
+			case "optional":
+				entry.Optional = splitList(value)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// splitList splits a space separated list. Like in data.go, two spaces give an
+// empty alias.
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, " ")
+}
+
+// Load adds the chord types of a dictionary file to the dictionary.
+// Returns an error, and adds nothing, if the file or one of its entries is
+// invalid.
+func Load(r io.Reader, format Format) error {
+	entries, err := Read(r, format)
+	if err != nil {
+		return err
+	}
+	chords := make([]ChordType, len(entries))
+	for i, entry := range entries {
+		if chords[i], err = entry.chordType(); err != nil {
+			return err
+		}
+	}
+	for _, chord := range chords {
+		add(chord)
+	}
+	return nil
+}
+
+// LoadFS adds the chord types of a dictionary file of a file system, such as an
+// embed.FS. The format is given by the extension of the file, see FormatOf.
+func LoadFS(fsys fs.FS, name string) error {
+	format, ok := FormatOf(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrFormat, name)
+	}
+	file, err := fsys.Open(name)
+	if err != nil {
+		return fmt.Errorf("chordtype: %w", err)
+	}
+	defer file.Close()
+	return Load(file, format)
+}
+
+// LoadFile adds the chord types of a dictionary file, see LoadFS.
+func LoadFile(name string) error {
+	format, ok := FormatOf(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrFormat, name)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("chordtype: %w", err)
+	}
+	defer file.Close()
+	return Load(file, format)
+}
+
+// Entries returns the entries of the dictionary, in the order of All.
+func Entries() []Entry {
+	entries := make([]Entry, len(dictionary))
+	for i, chord := range dictionary {
+		entries[i] = chord.Entry()
+	}
+	return entries
+}
+
+// Entry returns the chord type as an entry of a dictionary file.
+func (c ChordType) Entry() Entry {
+	return Entry{
+		Intervals: append([]string(nil), c.Intervals...),
+		Name:      c.Name,
+		Aliases:   append([]string(nil), c.Aliases...),
+		Quality:   c.Quality,
+		Tags:      append([]string(nil), c.Tags...),
+		Optional:  append([]string(nil), c.Optional...),
+	}
+}
+
+// Write writes the dictionary in a format Load reads back.
+func Write(w io.Writer, format Format) error {
+	return WriteEntries(w, Entries(), format)
+}
+
+// WriteEntries writes entries in a format Load reads back.
+func WriteEntries(w io.Writer, entries []Entry, format Format) error {
+	switch format {
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(entries); err != nil {
+			return fmt.Errorf("chordtype: %w", err)
+		}
+	case YAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(entries); err != nil {
+			return fmt.Errorf("chordtype: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return fmt.Errorf("chordtype: %w", err)
+		}
+	case CSV:
+		writer := csv.NewWriter(w)
+		records := [][]string{csvColumns}
+		for _, entry := range entries {
+			records = append(records, []string{
+				strings.Join(entry.Intervals, " "),
+				entry.Name,
+				strings.Join(entry.Aliases, " "),
+				string(entry.Quality),
+				strings.Join(entry.Tags, " "),
+				strings.Join(entry.Optional, " "),
+			})
+		}
+		if err := writer.WriteAll(records); err != nil {
+			return fmt.Errorf("chordtype: %w", err)
+		}
+	default:
+		return ErrFormat
+	}
+	return nil
+}
+
+// chordType returns the chord type of an entry.
+func (entry Entry) chordType() (ChordType, error) {
+	if len(entry.Intervals) == 0 {
+		return NoChordType, fmt.Errorf("chordtype: %q: %w", entry.Name, ErrNoIntervals)
+	}
+	set, err := pcset.IntervalsToPcset(entry.Intervals)
+	if err != nil {
+		return NoChordType, fmt.Errorf("chordtype: %q: %w", entry.Name, err)
+	}
+	quality := entry.Quality
+	if quality == "" {
+		quality = QualityOf(entry.Intervals)
+	} else if !isQuality(quality) {
+		return NoChordType, fmt.Errorf("chordtype: %q: %w %q", entry.Name, ErrQuality, quality)
+	}
+	for _, interval := range entry.Optional {
+		if !containsString(entry.Intervals, interval) {
+			return NoChordType, fmt.Errorf("chordtype: %q: %w %q", entry.Name, ErrOptional, interval)
+		}
+	}
+
+	return ChordType{
+		Pcset:     set,
+		Name:      entry.Name,
+		Quality:   quality,
+		Intervals: entry.Intervals,
+		Aliases:   entry.Aliases,
+		Tags:      entry.Tags,
+		Optional:  entry.Optional,
+	}, nil
+}
+
+func isColumn(name string) bool {
+	for _, column := range csvColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isQuality(quality ChordQuality) bool {
+	for _, q := range Qualities() {
+		if q == quality {
+			return true
+		}
+	}
+	return false
+}
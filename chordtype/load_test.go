@@ -0,0 +1,134 @@
+package chordtype
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/stretchr/testify/assert"
+)
+
+// resetDictionary restores the default dictionary after a test changed it.
+func resetDictionary() {
+	RemoveAll()
+	addDefaults()
+}
+
+func TestRead(t *testing.T) {
+	expected := []Entry{
+		{Intervals: []string{"1P", "3M", "5P", "7m", "9A"}, Name: "seventh sharp ninth", Aliases: []string{"7#9", "hendrix"}, Tags: []string{"jazz", "tetrad"}},
+		{Intervals: []string{"1P", "4P", "7m"}, Name: "quartal", Aliases: []string{"q3"}, Quality: Unknown},
+	}
+
+	json := `[
+		{"intervals": ["1P", "3M", "5P", "7m", "9A"], "name": "seventh sharp ninth", "aliases": ["7#9", "hendrix"], "tags": ["jazz", "tetrad"]},
+		{"intervals": ["1P", "4P", "7m"], "name": "quartal", "aliases": ["q3"], "quality": "Unknown"}
+	]`
+	yaml := `
+- intervals: [1P, 3M, 5P, 7m, 9A]
+  name: seventh sharp ninth
+  aliases: ["7#9", hendrix]
+  tags: [jazz, tetrad]
+- intervals: [1P, 4P, 7m]
+  name: quartal
+  aliases: [q3]
+  quality: Unknown
+`
+	csv := "name,intervals,aliases,tags,quality\n" +
+		"seventh sharp ninth,1P 3M 5P 7m 9A,7#9 hendrix,jazz tetrad,\n" +
+		"quartal,1P 4P 7m,q3,,Unknown\n"
+
+	for format, text := range map[Format]string{JSON: json, YAML: yaml, CSV: csv} {
+		entries, err := Read(strings.NewReader(text), format)
+		assert.NoError(t, err, format)
+		assert.Equal(t, expected, entries, format)
+	}
+
+	_, err := Read(strings.NewReader(""), "xml")
+	assert.ErrorIs(t, err, ErrFormat)
+	_, err = Read(strings.NewReader("intervals,symbol\n1P 5P,5\n"), CSV)
+	assert.ErrorIs(t, err, ErrColumn)
+	_, err = Read(strings.NewReader("[{"), JSON)
+	assert.Error(t, err)
+
+	entries, err := Read(strings.NewReader(""), YAML)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLoad(t *testing.T) {
+	defer resetDictionary()
+	count := len(All())
+
+	json := `[{"intervals": ["1P", "3M", "5P", "7m", "9A"], "name": "hendrix chord", "aliases": ["hendrix"], "tags": ["jazz"]}]`
+	assert.NoError(t, Load(strings.NewReader(json), JSON))
+	assert.Len(t, All(), count+1)
+	hendrix := Get("hendrix")
+	assert.Equal(t, "hendrix chord", hendrix.Name)
+	assert.Equal(t, Dominant, hendrix.Quality)
+	assert.Equal(t, []string{"jazz"}, hendrix.Tags)
+
+	// Nothing is added when an entry is invalid.
+	csv := "intervals,name,aliases\n1P 4P 7m,quartal,q3\n1P 3M 5M,bad,bad\n"
+	err := Load(strings.NewReader(csv), CSV)
+	assert.ErrorIs(t, err, pitchinterval.ErrMismatch)
+	assert.Len(t, All(), count+1)
+	assert.True(t, Get("q3").Empty)
+
+	err = Load(strings.NewReader("- intervals: [1P, 5P]\n  quality: Perfect\n"), YAML)
+	assert.ErrorIs(t, err, ErrQuality)
+	assert.EqualError(t, err, `chordtype: "": unknown chord quality "Perfect"`)
+
+	err = Load(strings.NewReader(`[{"name": "nothing"}]`), JSON)
+	assert.ErrorIs(t, err, ErrNoIntervals)
+	assert.EqualError(t, err, `chordtype: "nothing": no intervals`)
+	assert.Len(t, All(), count+1)
+	assert.True(t, Get("nothing").Empty)
+}
+
+func TestLoadFS(t *testing.T) {
+	defer resetDictionary()
+	fsys := fstest.MapFS{
+		"chords/pop.yaml": {Data: []byte("- intervals: [1P, 2M, 5P]\n  name: pop add two\n  aliases: [add2pop]\n  tags: [pop]\n")},
+		"chords/pop.txt":  {Data: []byte("")},
+	}
+	assert.NoError(t, LoadFS(fsys, "chords/pop.yaml"))
+	assert.Equal(t, []string{"pop"}, Get("add2pop").Tags)
+
+	assert.ErrorIs(t, LoadFS(fsys, "chords/pop.txt"), ErrFormat)
+	assert.Error(t, LoadFS(fsys, "chords/jazz.json"))
+	assert.Error(t, LoadFile("testdata/missing.csv"))
+}
+
+func TestWrite(t *testing.T) {
+	defer resetDictionary()
+	resetDictionary()
+	defaults := All()
+
+	for _, format := range []Format{JSON, YAML, CSV} {
+		var buffer bytes.Buffer
+		assert.NoError(t, Write(&buffer, format), format)
+
+		RemoveAll()
+		assert.NoError(t, Load(&buffer, format), format)
+		assert.Equal(t, defaults, All(), format)
+		assert.Equal(t, Get("major"), Get(""), format)
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WriteEntries(&buffer, []Entry{{Intervals: []string{"1P", "5P"}, Aliases: []string{"5"}, Quality: Unknown}}, CSV))
+	assert.Equal(t, "intervals,name,aliases,quality,tags,optional\n1P 5P,,5,Unknown,,\n", buffer.String())
+	assert.ErrorIs(t, Write(&buffer, "xml"), ErrFormat)
+}
+
+func TestFormatOf(t *testing.T) {
+	for name, expected := range map[string]Format{"a.json": JSON, "b.YML": YAML, "c.yaml": YAML, "dir/d.csv": CSV} {
+		format, ok := FormatOf(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, expected, format, name)
+	}
+	_, ok := FormatOf("chords")
+	assert.False(t, ok)
+}
@@ -0,0 +1,104 @@
+package chordtype
+
+import (
+	"sort"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+)
+
+// QualityOf returns the quality of a chord from its intervals. The first rule
+// that applies wins:
+//
+//   - Power: only roots and perfect fifths ("5").
+//   - Quartal: three notes or more stacked in perfect fourths ("1P 4P 7m 10m").
+//   - Suspended: no third, but a second or a fourth ("sus2", "7sus4", "11").
+//   - Dominant: a major third and a minor seventh ("7", "9", "7#5", "7alt").
+//   - Augmented: a major third and an augmented fifth ("aug", "maj7#5").
+//   - Major: a major third ("M", "maj7", "6", "Mb5").
+//   - HalfDiminished: a minor third, a diminished fifth and a minor seventh.
+//   - Diminished: a minor third and a diminished fifth ("dim", "dim7").
+//   - Minor: a minor third ("m", "m7", "m/ma7").
+//
+// Thirds, fifths and sevenths count in any octave, so "9A" is a ninth and not a
+// minor third. Invalid intervals are ignored; chords that match no rule are
+// Unknown.
+func QualityOf(names []string) ChordQuality {
+	intervals := make([]pitchinterval.Interval, 0, len(names))
+	for _, name := range names {
+		if interval := pitchinterval.Parse(name); !interval.Empty {
+			intervals = append(intervals, interval)
+		}
+	}
+	has := func(step int, alt int) bool {
+		for _, interval := range intervals {
+			if interval.Step == step && interval.Alt == alt {
+				return true
+			}
+		}
+		return false
+	}
+	hasStep := func(step int) bool {
+		for _, interval := range intervals {
+			if interval.Step == step {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Steps count from 0 (unison); Alt is 0 for major and perfect intervals, -1
+	// for minor and diminished perfect intervals, +1 for augmented ones.
+	majorThird, minorThird := has(2, 0), has(2, -1)
+	switch {
+	case isPower(intervals):
+		return Power
+	case isQuartal(intervals):
+		return Quartal
+	case !hasStep(2) && (hasStep(1) || has(3, 0)):
+		return Suspended
+	case majorThird && has(6, -1):
+		return Dominant
+	case majorThird && has(4, 1):
+		return Augmented
+	case majorThird:
+		return Major
+	case minorThird && has(4, -1) && has(6, -1):
+		return HalfDiminished
+	case minorThird && has(4, -1):
+		return Diminished
+	case minorThird:
+		return Minor
+	}
+	return Unknown
+}
+
+func isPower(intervals []pitchinterval.Interval) bool {
+	fifth := false
+	for _, interval := range intervals {
+		switch {
+		case interval.Step == 4 && interval.Alt == 0:
+			fifth = true
+		case interval.Step == 0 && interval.Alt == 0:
+		default:
+			return false
+		}
+	}
+	return fifth
+}
+
+func isQuartal(intervals []pitchinterval.Interval) bool {
+	if len(intervals) < 3 {
+		return false
+	}
+	semitones := make([]int, len(intervals))
+	for i, interval := range intervals {
+		semitones[i] = interval.Semitones
+	}
+	sort.Ints(semitones)
+	for i := 1; i < len(semitones); i++ {
+		if semitones[i]-semitones[i-1] != 5 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package chordtype
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityOf(t *testing.T) {
+	testCases := []struct {
+		intervals string
+		expected  ChordQuality
+	}{
+		{"1P 3M 5P", Major},
+		{"1P 3M 5P 7M 9M", Major},
+		{"1P 3M 5d", Major},
+		{"1P 3m 5P", Minor},
+		{"1P 3m 5P 7M", Minor},
+		{"1P 3m 5A", Minor},
+		{"1P 3M 5P 7m", Dominant},
+		{"1P 3M 7m 9m", Dominant},
+		{"1P 3M 5A 7m", Dominant},
+		{"1P 3M 5A", Augmented},
+		{"1P 3M 5A 7M", Augmented},
+		{"1P 3m 5d", Diminished},
+		{"1P 3m 5d 7d", Diminished},
+		{"1P 3m 5d 7m", HalfDiminished},
+		{"1P 2M 3m 5d 7m", HalfDiminished},
+		{"1P 4P 5P", Suspended},
+		{"1P 2M 5P", Suspended},
+		{"1P 4P 5P 7m 9m", Suspended},
+		{"1P 5P 7m 9M 11P", Suspended},
+		{"1P 5P", Power},
+		{"1P 5P 8P", Power},
+		{"1P 4P 7m 10m", Quartal},
+		{"1P 4P 7m", Quartal},
+		{"1P 4P", Suspended},
+		{"1P 5d", Unknown},
+		{"1P 3M 5P 9A", Major},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, QualityOf(strings.Split(tc.intervals, " ")), tc.intervals)
+	}
+}
+
+func TestQualities(t *testing.T) {
+	defer resetDictionary()
+	resetDictionary()
+
+	assert.Equal(t, Dominant, Get("7").Quality)
+	assert.Equal(t, HalfDiminished, Get("m7b5").Quality)
+	assert.Equal(t, Diminished, Get("dim7").Quality)
+	assert.Equal(t, Suspended, Get("sus4").Quality)
+	assert.Equal(t, Power, Get("5").Quality)
+	assert.Equal(t, Quartal, Get("quartal").Quality)
+
+	for _, chordType := range All() {
+		assert.Contains(t, Qualities(), chordType.Quality)
+	}
+}
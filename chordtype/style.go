@@ -0,0 +1,174 @@
+package chordtype
+
+// Style chooses how chord types are written in chord symbols.
+//
+// Symbols maps chord types to their symbol. A key is the name or one of the
+// aliases of a chord type; when several keys refer to the same chord type, the
+// name wins over the aliases, and the aliases are taken in order. Chord types
+// without a symbol in the map use their first alias. With FullNames, chords are
+// written with the full name of their chord type instead ("C major seventh over
+// E").
+//
+// The zero Style writes chord types with their first alias, like Detect does.
+type Style struct {
+	Name      string
+	Symbols   map[string]string
+	FullNames bool
+}
+
+var (
+	// Jazz writes lead sheet symbols: "C", "C-", "CΔ7", "C-7", "Cø7", "C°7".
+	Jazz = Style{Name: "jazz", Symbols: map[string]string{
+		"major":                        "",
+		"minor":                        "-",
+		"augmented":                    "+",
+		"diminished":                   "°",
+		"major seventh":                "Δ7",
+		"minor seventh":                "-7",
+		"dominant seventh":             "7",
+		"half-diminished":              "ø7",
+		"diminished seventh":           "°7",
+		"minor/major seventh":          "-Δ7",
+		"minor sixth":                  "-6",
+		"major ninth":                  "Δ9",
+		"minor ninth":                  "-9",
+		"minor eleventh":               "-11",
+		"major thirteenth":             "Δ13",
+		"minor thirteenth":             "-13",
+		"major seventh sharp eleventh": "Δ#11",
+		"suspended fourth seventh":     "7sus",
+		"minor augmented":              "-#5",
+		"7#5":                          "+7",
+	}}
+
+	// Pop writes guitar and songbook symbols: "C", "Cm", "Cmaj7", "Cm7b5",
+	// "Cdim7".
+	Pop = Style{Name: "pop", Symbols: map[string]string{
+		"major":               "",
+		"minor":               "m",
+		"augmented":           "aug",
+		"diminished":          "dim",
+		"major seventh":       "maj7",
+		"minor seventh":       "m7",
+		"dominant seventh":    "7",
+		"half-diminished":     "m7b5",
+		"diminished seventh":  "dim7",
+		"minor/major seventh": "mMaj7",
+		"sixth added ninth":   "6/9",
+		"suspended fourth":    "sus4",
+		"Madd9":               "add9",
+	}}
+
+	// Classical writes the full names of the chord types: "C major seventh over E".
+	Classical = Style{Name: "classical", FullNames: true}
+
+	// Berklee writes the symbols of the Berklee College of Music: "C-7", "C-7(b5)",
+	// "Co7", "C-(maj7)", "C7(b9)".
+	Berklee = Style{Name: "berklee", Symbols: map[string]string{
+		"major":                        "",
+		"minor":                        "-",
+		"augmented":                    "+",
+		"diminished":                   "o",
+		"major seventh":                "maj7",
+		"minor seventh":                "-7",
+		"dominant seventh":             "7",
+		"half-diminished":              "-7(b5)",
+		"diminished seventh":           "o7",
+		"minor/major seventh":          "-(maj7)",
+		"minor sixth":                  "-6",
+		"minor ninth":                  "-9",
+		"minor eleventh":               "-11",
+		"minor thirteenth":             "-13",
+		"major seventh sharp eleventh": "maj7(#11)",
+		"dominant flat ninth":          "7(b9)",
+		"dominant sharp ninth":         "7(#9)",
+		"lydian dominant seventh":      "7(#11)",
+		"7b13":                         "7(b13)",
+		"suspended fourth":             "sus4",
+		"suspended fourth seventh":     "7sus4",
+		"sixth added ninth":            "6/9",
+		"7#5":                          "+7",
+	}}
+)
+
+// Styles returns the named styles.
+func Styles() []Style {
+	return []Style{Jazz, Pop, Classical, Berklee}
+}
+
+// StyleNamed returns the named style with that name ("jazz", "pop",
+// "classical", "berklee"), or false.
+func StyleNamed(name string) (Style, bool) {
+	for _, style := range Styles() {
+		if style.Name == name {
+			return style, true
+		}
+	}
+	return Style{}, false
+}
+
+// With returns a copy of the style with more symbols, which replace the symbols
+// the style has for the same chord types: Jazz.With(map[string]string{"maj7":
+// "maj7"}) is the jazz style with "Cmaj7" instead of "CΔ7".
+func (s Style) With(symbols map[string]string) Style {
+	result := Style{Name: s.Name, Symbols: make(map[string]string), FullNames: s.FullNames}
+	for key, symbol := range s.Symbols {
+		chord := Get(key)
+		replaced := false
+		for other := range symbols {
+			if other == key || !chord.Empty && Get(other).Chroma == chord.Chroma {
+				replaced = true
+			}
+		}
+		if !replaced {
+			result.Symbols[key] = symbol
+		}
+	}
+	for key, symbol := range symbols {
+		result.Symbols[key] = symbol
+	}
+	return result
+}
+
+// Symbol returns the symbol of the chord type in the style.
+func (s Style) Symbol(c ChordType) string {
+	if c.Name != "" {
+		if symbol, ok := s.Symbols[c.Name]; ok {
+			return symbol
+		}
+	}
+	for _, alias := range c.Aliases {
+		if symbol, ok := s.Symbols[alias]; ok && alias != "" {
+			return symbol
+		}
+	}
+	if len(c.Aliases) > 0 {
+		return c.Aliases[0]
+	}
+	return ""
+}
+
+// Format writes a chord of the chord type in the style: "Cmaj7/E", or "C major
+// seventh over E" with full names. Bass is empty for chords in root position.
+func (s Style) Format(tonic string, c ChordType, bass string) string {
+	if s.FullNames {
+		name := c.Name
+		if name == "" {
+			name = s.Symbol(c)
+		}
+		return tonic + " " + name + s.FormatBass(bass)
+	}
+	return tonic + s.Symbol(c) + s.FormatBass(bass)
+}
+
+// FormatBass writes the bass of a chord in the style: "/E", or " over E" with
+// full names. Returns an empty string when bass is empty.
+func (s Style) FormatBass(bass string) string {
+	switch {
+	case bass == "":
+		return ""
+	case s.FullNames:
+		return " over " + bass
+	}
+	return "/" + bass
+}
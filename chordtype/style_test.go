@@ -0,0 +1,75 @@
+package chordtype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStyleSymbol(t *testing.T) {
+	tests := []struct {
+		style    Style
+		chord    string
+		expected string
+	}{
+		{Style{}, "major seventh", "maj7"},
+		{Style{}, "half-diminished", "m7b5"},
+		{Jazz, "major seventh", "Δ7"},
+		{Jazz, "half-diminished", "ø7"},
+		{Jazz, "diminished seventh", "°7"},
+		{Jazz, "minor seventh", "-7"},
+		{Jazz, "7b9", "7b9"},
+		{Pop, "major", ""},
+		{Pop, "half-diminished", "m7b5"},
+		{Pop, "Madd9", "add9"},
+		{Berklee, "half-diminished", "-7(b5)"},
+		{Berklee, "7#5", "+7"},
+		{Style{Symbols: map[string]string{"maj7": "M7"}}, "major seventh", "M7"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.style.Symbol(Get(test.chord)), "%s %s", test.style.Name, test.chord)
+	}
+	assert.Equal(t, "", Jazz.Symbol(NoChordType))
+}
+
+func TestStyleSymbolsAreAliases(t *testing.T) {
+	for _, style := range []Style{Jazz, Pop, Berklee} {
+		for key, symbol := range style.Symbols {
+			chord := Get(key)
+			assert.False(t, chord.Empty, "%s: %q should be a chord type", style.Name, key)
+			assert.Equal(t, chord.Chroma, Get(symbol).Chroma, "%s: %q should be an alias of %q", style.Name, symbol, key)
+		}
+	}
+}
+
+func TestStyleFormat(t *testing.T) {
+	maj7 := Get("maj7")
+	assert.Equal(t, "Cmaj7", Style{}.Format("C", maj7, ""))
+	assert.Equal(t, "CΔ7/E", Jazz.Format("C", maj7, "E"))
+	assert.Equal(t, "C major seventh over E", Classical.Format("C", maj7, "E"))
+	assert.Equal(t, "C 7b5", Classical.Format("C", Get("7b5"), ""))
+
+	assert.Equal(t, "/E", Jazz.FormatBass("E"))
+	assert.Equal(t, " over E", Classical.FormatBass("E"))
+	assert.Equal(t, "", Classical.FormatBass(""))
+}
+
+func TestStyleNamed(t *testing.T) {
+	style, ok := StyleNamed("berklee")
+	assert.True(t, ok)
+	assert.Equal(t, Berklee, style)
+
+	_, ok = StyleNamed("baroque")
+	assert.False(t, ok)
+}
+
+func TestStyleWith(t *testing.T) {
+	style := Jazz.With(map[string]string{"maj7": "maj7", "mystery": "?"})
+	assert.Equal(t, "jazz", style.Name)
+	assert.Equal(t, "maj7", style.Symbol(Get("major seventh")))
+	assert.Equal(t, "-7", style.Symbol(Get("minor seventh")))
+	assert.NotContains(t, style.Symbols, "major seventh")
+
+	// Jazz is unchanged.
+	assert.Equal(t, "Δ7", Jazz.Symbol(Get("major seventh")))
+}
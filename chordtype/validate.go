@@ -0,0 +1,229 @@
+package chordtype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ProblemKind is the kind of a problem found by Validate.
+type ProblemKind string
+
+const (
+	// The entry has no intervals or intervals that can't be parsed, or its
+	// quality or optional intervals are invalid (Err says why).
+	InvalidIntervals ProblemKind = "invalid intervals"
+	// Two entries have the same chroma: Get by chroma or SetNum only returns
+	// the last one.
+	DuplicateChroma ProblemKind = "duplicate chroma"
+	// Two entries have the same alias or the same name: Get only returns the
+	// last one.
+	AliasCollision ProblemKind = "alias collision"
+	// An alias of an entry is the full name of another one.
+	AliasShadowsName ProblemKind = "alias shadows name"
+	// The symbol detection results use for the entry, its first alias, is
+	// missing or gets another entry, so a detected chord can't be parsed back to
+	// the entry.
+	Undetectable ProblemKind = "undetectable"
+)
+
+// Problem is an issue of a chord type dictionary. Key is the alias, name or
+// chroma in conflict, and Other the other entry involved, if any.
+type Problem struct {
+	Kind  ProblemKind
+	Entry Entry
+	Other Entry
+	Key   string
+	Err   error
+}
+
+func (p Problem) Error() string {
+	message := string(p.Kind)
+	switch p.Kind {
+	case InvalidIntervals:
+		// The error of the intervals already names the entry.
+		return p.Err.Error()
+	case DuplicateChroma:
+		message = fmt.Sprintf("chroma %s is also the chroma of %q", p.Key, label(p.Other))
+	case AliasCollision:
+		message = fmt.Sprintf("%q is also used by %q", p.Key, label(p.Other))
+	case AliasShadowsName:
+		message = fmt.Sprintf("alias %q is the name of %q", p.Key, label(p.Other))
+	case Undetectable:
+		if p.Key == "" && len(p.Entry.Aliases) == 0 {
+			message = "no alias to name detected chords"
+		} else {
+			message = fmt.Sprintf("detected chords named %q are %q", p.Key, label(p.Other))
+		}
+	}
+	return fmt.Sprintf("chordtype: %q: %s", label(p.Entry), message)
+}
+
+func (p Problem) Unwrap() error {
+	return p.Err
+}
+
+// label returns the name of an entry, or its first alias, or its intervals.
+func label(entry Entry) string {
+	switch {
+	case entry.Name != "":
+		return entry.Name
+	case len(entry.Aliases) > 0 && entry.Aliases[0] != "":
+		return entry.Aliases[0]
+	}
+	return strings.Join(entry.Intervals, " ")
+}
+
+// Validate returns the problems of the dictionary, see ValidateEntries.
+func Validate() []Problem {
+	return ValidateEntries(Entries())
+}
+
+// ValidateEntries returns the problems of a list of entries, such as the ones of
+// a dictionary file, added in that order: invalid intervals, duplicate chromas,
+// alias collisions, aliases that are the name of another entry, and entries that
+// can't be detected. Problems are ordered by entry.
+func ValidateEntries(entries []Entry) []Problem {
+	v := newValidator(entries)
+	problems := make([]Problem, 0)
+	for i := range entries {
+		for _, found := range v.check(i) {
+			problems = append(problems, found.Problem)
+		}
+	}
+	return problems
+}
+
+// AddStrict adds a chord to the dictionary like AddEntry, unless it has invalid
+// intervals or conflicts with the chord types of the dictionary: it has the
+// chroma of another chord type, one of its aliases or its name is already used,
+// or it has no alias. Returns the first Problem found, and adds nothing,
+// otherwise.
+func AddStrict(entry Entry) error {
+	entries := append(Entries(), entry)
+	added := len(entries) - 1
+	v := newValidator(entries)
+	if problems := v.check(added); len(problems) > 0 {
+		return problems[0].Problem
+	}
+	// The entry can also take an alias from another chord type.
+	for i := 0; i < added; i++ {
+		for _, found := range v.check(i) {
+			if found.other == added {
+				return found.Problem
+			}
+		}
+	}
+	return AddEntry(entry)
+}
+
+// A validator checks entries against the index Add would build from them.
+type validator struct {
+	entries []Entry
+	valid   []bool
+	chromas []string
+	keys    map[string]int
+}
+
+// A problem with the position of its other entry, -1 if there is none.
+type found struct {
+	Problem
+	other int
+}
+
+func newValidator(entries []Entry) validator {
+	v := validator{
+		entries: entries,
+		valid:   make([]bool, len(entries)),
+		chromas: make([]string, len(entries)),
+		keys:    make(map[string]int),
+	}
+	// The entries with invalid intervals are left out of the index.
+	for i, entry := range entries {
+		chord, err := entry.chordType()
+		if err != nil {
+			continue
+		}
+		set := chord.Pcset
+		v.valid[i], v.chromas[i] = true, set.Chroma
+		if entry.Name != "" {
+			v.keys[entry.Name] = i
+		}
+		v.keys[strconv.Itoa(set.SetNum)] = i
+		v.keys[set.Chroma] = i
+		for _, alias := range entry.Aliases {
+			v.keys[alias] = i
+		}
+	}
+	return v
+}
+
+// check returns the problems of the entry at position i.
+func (v validator) check(i int) []found {
+	entry := v.entries[i]
+	result := make([]found, 0)
+	problem := func(kind ProblemKind, other int, key string) {
+		f := found{Problem: Problem{Kind: kind, Entry: entry, Key: key}, other: other}
+		if other >= 0 {
+			f.Other = v.entries[other]
+		}
+		result = append(result, f)
+	}
+
+	if !v.valid[i] {
+		_, err := entry.chordType()
+		result = append(result, found{Problem: Problem{Kind: InvalidIntervals, Entry: entry, Err: err}, other: -1})
+		return result
+	}
+
+	for j := 0; j < i; j++ {
+		if v.valid[j] && v.chromas[j] == v.chromas[i] {
+			problem(DuplicateChroma, j, v.chromas[i])
+			break
+		}
+	}
+
+	if entry.Name != "" {
+		for j := 0; j < i; j++ {
+			if v.valid[j] && v.entries[j].Name == entry.Name {
+				problem(AliasCollision, j, entry.Name)
+				break
+			}
+		}
+	}
+	for k, alias := range entry.Aliases {
+		if containsString(entry.Aliases[:k], alias) {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if v.valid[j] && containsString(v.entries[j].Aliases, alias) {
+				problem(AliasCollision, j, alias)
+				break
+			}
+		}
+	}
+
+	for _, alias := range entry.Aliases {
+		for j, other := range v.entries {
+			if j != i && v.valid[j] && alias != "" && other.Name == alias {
+				problem(AliasShadowsName, j, alias)
+			}
+		}
+	}
+
+	if len(entry.Aliases) == 0 {
+		problem(Undetectable, -1, "")
+	} else if j := v.keys[entry.Aliases[0]]; j != i {
+		problem(Undetectable, j, entry.Aliases[0])
+	}
+	return result
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package chordtype
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	defer resetDictionary()
+	resetDictionary()
+
+	// The built-in dictionary only has chord types spelled differently with the
+	// same notes, like tonal.
+	problems := Validate()
+	assert.Len(t, problems, 6)
+	for _, problem := range problems {
+		assert.Equal(t, DuplicateChroma, problem.Kind, problem.Error())
+	}
+	assert.EqualError(t, problems[0], `chordtype: "augmented seventh": chroma 100010001001 is also the chroma of "major seventh flat sixth"`)
+}
+
+func TestValidateEntries(t *testing.T) {
+	major := Entry{Intervals: []string{"1P", "3M", "5P"}, Name: "major", Aliases: []string{"M"}}
+	maj7 := Entry{Intervals: []string{"1P", "3M", "5P", "7M"}, Name: "major seventh", Aliases: []string{"Δ", "maj7"}}
+	maj9 := Entry{Intervals: []string{"1P", "3M", "5P", "7M", "9M"}, Name: "major ninth", Aliases: []string{"maj9", "Δ"}}
+	bad := Entry{Intervals: []string{"1P", "3M", "5M"}, Name: "bad", Aliases: []string{"bad"}}
+	triad := Entry{Intervals: []string{"1P", "3M", "5P"}, Name: "triad", Aliases: []string{"tri", "major"}}
+	nameless := Entry{Intervals: []string{"1P", "4P", "7m"}}
+
+	problems := ValidateEntries([]Entry{major, maj7, maj9, bad, triad, nameless})
+	assert.Len(t, problems, 6)
+
+	// Detected major sevenths are named "Δ", which is now the major ninth.
+	assert.Equal(t, []Problem{
+		{Kind: Undetectable, Entry: maj7, Other: maj9, Key: "Δ"},
+		{Kind: AliasCollision, Entry: maj9, Other: maj7, Key: "Δ"},
+	}, problems[:2])
+	assert.EqualError(t, problems[0], `chordtype: "major seventh": detected chords named "Δ" are "major ninth"`)
+	assert.EqualError(t, problems[1], `chordtype: "major ninth": "Δ" is also used by "major seventh"`)
+
+	assert.Equal(t, InvalidIntervals, problems[2].Kind)
+	assert.True(t, errors.Is(problems[2], pitchinterval.ErrMismatch))
+	assert.EqualError(t, problems[2], `chordtype: "bad": pitchinterval: "5M": quality doesn't match the interval number`)
+
+	assert.Equal(t, []Problem{
+		{Kind: DuplicateChroma, Entry: triad, Other: major, Key: "100010010000"},
+		{Kind: AliasShadowsName, Entry: triad, Other: major, Key: "major"},
+		{Kind: Undetectable, Entry: nameless},
+	}, problems[3:])
+	assert.EqualError(t, problems[4], `chordtype: "triad": alias "major" is the name of "major"`)
+	assert.EqualError(t, problems[5], `chordtype: "1P 4P 7m": no alias to name detected chords`)
+
+	assert.Empty(t, ValidateEntries([]Entry{major, maj9}))
+
+	maj9.Optional = []string{"5P", "6M"}
+	problems = ValidateEntries([]Entry{major, maj9})
+	assert.Len(t, problems, 1)
+	assert.Equal(t, InvalidIntervals, problems[0].Kind)
+	assert.ErrorIs(t, problems[0], ErrOptional)
+
+	problems = ValidateEntries([]Entry{{Name: "empty"}})
+	assert.Len(t, problems, 1)
+	assert.Equal(t, InvalidIntervals, problems[0].Kind)
+	assert.ErrorIs(t, problems[0], ErrNoIntervals)
+	assert.EqualError(t, problems[0], `chordtype: "empty": no intervals`)
+}
+
+func TestAddStrict(t *testing.T) {
+	defer resetDictionary()
+	resetDictionary()
+	count := len(All())
+
+	assert.NoError(t, AddStrict(Entry{Intervals: []string{"1P", "2M", "3M", "4A"}, Name: "lydian tetrachord", Aliases: []string{"lyd"}}))
+	assert.Len(t, All(), count+1)
+
+	testCases := []struct {
+		entry Entry
+		kind  ProblemKind
+	}{
+		{Entry{Intervals: []string{"1P", "3M", "5P"}, Name: "triad", Aliases: []string{"tri"}}, DuplicateChroma},
+		{Entry{Intervals: []string{"1P", "2m", "5P"}, Name: "add two", Aliases: []string{"Δ"}}, AliasCollision},
+		{Entry{Intervals: []string{"1P", "2m", "5P"}, Name: "add two", Aliases: []string{"lyd"}}, AliasCollision},
+		{Entry{Intervals: []string{"1P", "2m", "5P"}, Name: "add two", Aliases: []string{"mu", "minor"}}, AliasShadowsName},
+		{Entry{Intervals: []string{"1P", "2m", "5P"}, Name: "add two"}, Undetectable},
+		{Entry{Intervals: []string{"1P", "2m", "5M"}, Name: "add two", Aliases: []string{"mu"}}, InvalidIntervals},
+		{Entry{Name: "empty", Aliases: []string{"mu"}}, InvalidIntervals},
+	}
+	for _, tc := range testCases {
+		err := AddStrict(tc.entry)
+		var problem Problem
+		assert.True(t, errors.As(err, &problem), tc.entry.Name)
+		assert.Equal(t, tc.kind, problem.Kind, err.Error())
+	}
+	assert.Len(t, All(), count+1)
+}
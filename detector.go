@@ -4,20 +4,227 @@ package detector
 import (
 	"fmt"
 	"sort"
-	"strconv"
+	"strings"
 
 	"github.com/Golevka2001/go-chord-detector/chordtype"
 	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
 	"github.com/go-music-theory/music-theory/note"
 )
 
+// FoundChord is a detected chord.
+//
+// Name is the chord symbol ("Dm7b5", "C#m7/E"): the root, the symbol of the
+// chord type in the Style of the options (its first alias by default), and the
+// bass when it is not the root. Bass is empty for chords in root position.
+// Omitted are the intervals of the chord type missing from the notes, which the
+// name lists before the bass: "C13 (no 5, no 9)/E". Weight is 1 for chords in
+// root position and 0.5 for inversions, times 0.8 for each omitted interval.
+// Quality is the quality of the chord type. ForeignBass is true for slash
+// chords whose bass is not a chord tone ("C/F#"), which weigh 0.25. Rootless
+// chords, whose root is not in the notes, come after the other chords and have
+// weights of their own, see RootlessOptions.
 type FoundChord struct {
-	Weight float64
-	Name   string
+	Weight      float64
+	Name        string
+	Root        string
+	Bass        string
+	ChordType   chordtype.ChordType
+	Quality     chordtype.ChordQuality
+	Omitted     []string
+	Rootless    bool
+	ForeignBass bool
 }
 
+// Notes returns the notes of the chord, spelled from the root and the intervals
+// of the chord type, whatever the style of its name, without the omitted
+// intervals: "C13 (no 5, no 9)" is C E Bb A and "Cmaj9 (no root)" is E G B D.
+// Chords with a bass start with it, like chord.Chord.Notes: "Cmaj7 (no 5)/E" is
+// E B C and "C/F#" is F# C E G.
+//
+// Returns nil if the root is not a note name.
+func (c FoundChord) Notes() []pitch.Note {
+	root, err := pitch.ParseNote(c.Root)
+	if err != nil {
+		return nil
+	}
+
+	notes := make([]pitch.Note, 0, len(c.ChordType.Intervals)+1)
+	for _, name := range c.ChordType.Intervals {
+		interval := pitchinterval.Parse(name)
+		if interval.Empty || containsString(c.Omitted, name) {
+			continue
+		}
+		notes = append(notes, pitch.NoteOf(pitch.Decode(root.Coordinates().Add(interval.Coord))))
+	}
+
+	bass, err := pitch.ParseNote(c.Bass)
+	if err != nil {
+		return notes
+	}
+	for i, n := range notes {
+		if n == bass.PitchClass() {
+			return append(notes[i:], notes[:i]...)
+		}
+	}
+	return append([]pitch.Note{bass.PitchClass()}, notes...)
+}
+
+// NoteNames returns the names of the notes of the chord, see Notes.
+func (c FoundChord) NoteNames() []string {
+	notes := c.Notes()
+	if notes == nil {
+		return nil
+	}
+	names := make([]string, len(notes))
+	for i, n := range notes {
+		names[i] = n.String()
+	}
+	return names
+}
+
+// DetectOptions changes how chords are detected.
+//
+// Style writes the names of the chords, see chordtype.Style. Omit lets chords
+// miss some of their optional intervals, see OmitPolicy. Rootless also proposes
+// chords whose root is missing, see RootlessOptions. ForeignBass also names
+// the notes above the bass when the bass is not a chord tone: "F# C E G" is
+// "C/F#".
+//
+// The other fields limit the chord types detection uses, and are ignored when
+// empty: chord types must have all the Tags and none of the ExcludeTags, one of
+// the Qualities, at most MaxNotes notes, and, when Allow is set, a name or alias
+// in Allow. Chord types with a name or alias in Deny are never used.
 type DetectOptions struct {
 	AssumePerfectFifth bool
+	Style              chordtype.Style
+	Omit               OmitPolicy
+	Rootless           RootlessOptions
+	ForeignBass        bool
+
+	Tags        []string
+	ExcludeTags []string
+	Qualities   []chordtype.ChordQuality
+	MaxNotes    int
+	Allow       []string
+	Deny        []string
+}
+
+// OmitPolicy says which chord tones detection may assume when the notes miss
+// them. Only the optional intervals of the chord types can be assumed (see
+// chordtype.ChordType): all of them with Optional, or the ones in Intervals
+// ("5P", "9M"). When Max is not 0, chords miss at most Max intervals. The zero
+// OmitPolicy assumes nothing.
+type OmitPolicy struct {
+	Optional  bool
+	Intervals []string
+	Max       int
+}
+
+// omissionWeight multiplies the weight of a chord for each omitted interval.
+const omissionWeight = 0.8
+
+// omitted returns the intervals of the chord type the mode misses, and false if
+// the mode is not the chord type with some optional intervals left out.
+func (policy OmitPolicy) omitted(chordType chordtype.ChordType, mode pcset.Set) ([]string, bool) {
+	chord := chordType.Set()
+	if !mode.Has(0) || mode == chord || !mode.IsSubsetOf(chord) {
+		return nil, false
+	}
+	missing := chord.Difference(mode)
+	var omitted []string
+	var assumed pcset.Set
+	for _, interval := range chordType.Optional {
+		if !policy.Optional && !containsString(policy.Intervals, interval) {
+			continue
+		}
+		set, err := pcset.IntervalsToSet([]string{interval})
+		if err == nil && set.IsSubsetOf(missing) && !set.IsSubsetOf(assumed) {
+			omitted = append(omitted, interval)
+			assumed = assumed.Union(set)
+		}
+	}
+	if assumed != missing || policy.Max > 0 && len(omitted) > policy.Max {
+		return nil, false
+	}
+	return omitted, true
+}
+
+// omissionsName lists omitted intervals by number: "(no 5, no 9)", "(no root)".
+func omissionsName(omitted []string) string {
+	names := make([]string, len(omitted))
+	for i, name := range omitted {
+		if num := pitchinterval.Parse(name).Num; num == 1 {
+			names[i] = "no root"
+		} else {
+			names[i] = fmt.Sprintf("no %d", num)
+		}
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
+
+// chordName writes a chord in the style of the options, with the omitted
+// intervals before the bass: "C13 (no 5)/E".
+func (options DetectOptions) chordName(root string, chordType chordtype.ChordType, omitted []string, bass string) string {
+	name := options.Style.Format(root, chordType, "")
+	if len(omitted) > 0 {
+		name += " " + omissionsName(omitted)
+	}
+	return name + options.Style.FormatBass(bass)
+}
+
+// accepts returns true if the chord type passes the filters of the options.
+func (options DetectOptions) accepts(chordType chordtype.ChordType) bool {
+	for _, tag := range options.Tags {
+		if !chordType.HasTag(tag) {
+			return false
+		}
+	}
+	for _, tag := range options.ExcludeTags {
+		if chordType.HasTag(tag) {
+			return false
+		}
+	}
+	if len(options.Qualities) > 0 {
+		accepted := false
+		for _, quality := range options.Qualities {
+			accepted = accepted || chordType.Quality == quality
+		}
+		if !accepted {
+			return false
+		}
+	}
+	if options.MaxNotes > 0 && len(chordType.Intervals) > options.MaxNotes {
+		return false
+	}
+	if len(options.Allow) > 0 && !isNamed(chordType, options.Allow) {
+		return false
+	}
+	return !isNamed(chordType, options.Deny)
+}
+
+// isNamed returns true if the name or one of the aliases of the chord type is
+// in names.
+func isNamed(chordType chordtype.ChordType, names []string) bool {
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if chordType.Name == name || containsString(chordType.Aliases, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 func Detect(notes []*note.Note) []string {
@@ -25,33 +232,80 @@ func Detect(notes []*note.Note) []string {
 }
 
 func DetectWithOptions(source []*note.Note, options DetectOptions) []string {
+	return detect(source, sharpSpelling, options)
+}
+
+// DetectPitches is like Detect for spelled notes: the roots and the bass of the
+// chords keep the spelling of the notes, "Db F Ab" is "DbM" and not "C#M".
+func DetectPitches(notes []pitch.Note) []string {
+	return DetectPitchesWithOptions(notes, DetectOptions{})
+}
+
+func DetectPitchesWithOptions(notes []pitch.Note, options DetectOptions) []string {
+	return detect(pitch.ToNotes(notes), spellingOf(notes), options)
+}
+
+// spelling is the name of each pitch class, 0 is C.
+type spelling [12]string
+
+var sharpSpelling = spellingOf(nil)
+
+// spellingOf names each pitch class like the first note that has it, or with
+// sharps.
+func spellingOf(notes []pitch.Note) spelling {
+	var names spelling
+	for _, n := range notes {
+		if n.Letter != 0 && names[n.Chroma()] == "" {
+			names[n.Chroma()] = n.PitchClass().String()
+		}
+	}
+	for pc, name := range names {
+		if name == "" {
+			names[pc] = note.Class(pc + 1).String(note.Sharp)
+		}
+	}
+	return names
+}
+
+// DetectChords is like DetectWithOptions, with the root, bass, chord type and
+// quality of each chord.
+func DetectChords(source []*note.Note, options DetectOptions) []FoundChord {
+	return detectChords(source, sharpSpelling, options)
+}
+
+// DetectPitchChords is like DetectPitchesWithOptions, with the root, bass, chord
+// type and quality of each chord.
+func DetectPitchChords(notes []pitch.Note, options DetectOptions) []FoundChord {
+	return detectChords(pitch.ToNotes(notes), spellingOf(notes), options)
+}
+
+func detect(source []*note.Note, names spelling, options DetectOptions) []string {
 	if len(source) == 0 {
 		return make([]string, 0)
 	}
 
-	found := findMatches(source, 1.0, options)
-
 	var result []string
+	for _, chord := range detectChords(source, names, options) {
+		result = append(result, chord.Name)
+	}
+	return result
+}
+
+// detectChords returns the chords with a weight, the heaviest first.
+func detectChords(source []*note.Note, names spelling, options DetectOptions) []FoundChord {
+	result := make([]FoundChord, 0)
+	found := findMatches(source, names, 1.0, options)
+	found = append(found, findForeignBass(source, names, 1.0, found, options)...)
 	for _, chord := range found {
 		if chord.Weight > 0 {
-			result = append(result, chord.Name)
+			result = append(result, chord)
 		}
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		var weightI, weightJ float64
-		for _, f := range found {
-			if f.Name == result[i] {
-				weightI = f.Weight
-			}
-			if f.Name == result[j] {
-				weightJ = f.Weight
-			}
-		}
-		return weightI > weightJ
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Weight > result[j].Weight
 	})
-
-	return result
+	return append(result, findRootless(source, names, options)...)
 }
 
 const (
@@ -68,105 +322,174 @@ const (
 	AnySeventhMask = 3 // 0b000000000011 = 3
 )
 
-func hasAnyThird(chromaNumber int) bool {
-	return chromaNumber&AnyThirdsMask != 0
+func hasAnyThird(set pcset.Set) bool {
+	return set&AnyThirdsMask != 0
 }
 
-func hasPerfectFifth(chromaNumber int) bool {
-	return chromaNumber&PerfectFifthMask != 0
+func hasPerfectFifth(set pcset.Set) bool {
+	return set&PerfectFifthMask != 0
 }
 
-func hasAnySeventh(chromaNumber int) bool {
-	return chromaNumber&AnySeventhMask != 0
+func hasAnySeventh(set pcset.Set) bool {
+	return set&AnySeventhMask != 0
 }
 
-func hasNonPerfectFifth(chromaNumber int) bool {
-	return chromaNumber&NonPerfectFifthsMask != 0
+func hasNonPerfectFifth(set pcset.Set) bool {
+	return set&NonPerfectFifthsMask != 0
 }
 
 func hasAnyThirdAndPerfectFifthAndAnySeventh(chordType chordtype.ChordType) bool {
-	chromaNumber, _ := strconv.ParseInt(chordType.Chroma, 2, 64)
-	return hasAnyThird(int(chromaNumber)) && hasPerfectFifth(int(chromaNumber)) && hasAnySeventh(int(chromaNumber))
+	set := chordType.Set()
+	return hasAnyThird(set) && hasPerfectFifth(set) && hasAnySeventh(set)
 }
 
 func withPerfectFifth(chroma string) string {
-	chromaNumber, _ := strconv.ParseInt(chroma, 2, 64)
-	if hasNonPerfectFifth(int(chromaNumber)) {
+	set, _ := pcset.ChromaToSet(chroma)
+	if hasNonPerfectFifth(set) {
 		return chroma
 	}
-	newChroma := int(chromaNumber) | PerfectFifthMask
-	return chromaToString(newChroma)
+	return set.Union(PerfectFifthMask).Chroma()
 }
 
-func chromaToString(chroma int) string {
-	binary := make([]byte, 12)
-	for i := 0; i < 12; i++ {
-		if chroma&(1<<(11-i)) != 0 {
-			binary[i] = '1'
+// matchChordTypes returns the chord types of a mode, with the intervals each one
+// omits. Chord types that omit intervals are only returned when no chord type
+// matches the mode exactly: "C E Bb D A" is "C13no5", not "C13 (no 5)".
+func matchChordTypes(mode string, options DetectOptions) ([]chordtype.ChordType, [][]string) {
+	modeWithPerfectFifth := mode
+	if options.AssumePerfectFifth {
+		modeWithPerfectFifth = withPerfectFifth(mode)
+	}
+	modeSet, _ := pcset.ChromaToSet(mode)
+
+	var chordTypes, omitting []chordtype.ChordType
+	var omissions [][]string
+	for _, chordType := range chordtype.All() {
+		if !options.accepts(chordType) {
+			continue
+		}
+		var matches bool
+		if options.AssumePerfectFifth && hasAnyThirdAndPerfectFifthAndAnySeventh(chordType) {
+			matches = chordType.Chroma == modeWithPerfectFifth
 		} else {
-			binary[i] = '0'
+			matches = chordType.Pcset.Chroma == mode
 		}
+		if matches {
+			chordTypes = append(chordTypes, chordType)
+		} else if omitted, ok := options.Omit.omitted(chordType, modeSet); ok {
+			omitting = append(omitting, chordType)
+			omissions = append(omissions, omitted)
+		}
+	}
+	if len(chordTypes) > 0 {
+		return chordTypes, make([][]string, len(chordTypes))
 	}
-	return string(binary)
+	return omitting, omissions
 }
 
-func findMatches(notes []*note.Note, weight float64, options DetectOptions) []FoundChord {
+func findMatches(notes []*note.Note, names spelling, weight float64, options DetectOptions) []FoundChord {
 	if len(notes) == 0 {
 		return make([]FoundChord, 0)
 	}
 
 	tonic := notes[0]
 	tonicChroma := (int(tonic.Class) - 1) % 12
+	bass := ""
+	if tonic.Class != note.Nil {
+		bass = names[tonicChroma]
+	}
 
 	// We need to test all notes to get the correct baseNote
 	allModes := pcset.Modes(notes, false)
 
 	var found []FoundChord
 	for index, mode := range allModes {
-		modeWithPerfectFifth := mode
-		if options.AssumePerfectFifth {
-			modeWithPerfectFifth = withPerfectFifth(mode)
-		}
-
 		// Some chords could have the same chroma but different interval spelling
-		var chordTypes []chordtype.ChordType
-		for _, chordType := range chordtype.All() {
-			if options.AssumePerfectFifth && hasAnyThirdAndPerfectFifthAndAnySeventh(chordType) {
-				if chordType.Chroma == modeWithPerfectFifth {
-					chordTypes = append(chordTypes, chordType)
-				}
-			} else {
-				if chordType.Pcset.Chroma == mode {
-					chordTypes = append(chordTypes, chordType)
-				}
-			}
-		}
-
-		for _, chordType := range chordTypes {
-			chordName := ""
-			if len(chordType.Aliases) > 0 {
-				chordName = chordType.Aliases[0]
-			}
-
+		chordTypes, omissions := matchChordTypes(mode, options)
+		for i, chordType := range chordTypes {
 			if index >= int(note.B) {
 				continue
 			}
-			baseNote := note.Class(index + 1).String(note.Sharp) // `0` is defined as `Nil`
+			baseNote := names[index]
 			isInversion := index != tonicChroma
 
+			chord := FoundChord{
+				Weight:    1.0 * weight,
+				Root:      baseNote,
+				ChordType: chordType,
+				Quality:   chordType.Quality,
+			}
 			if isInversion {
-				found = append(found, FoundChord{
-					Weight: 0.5 * weight,
-					Name:   fmt.Sprintf("%s%s/%s", baseNote, chordName, tonic.Class.String(note.Sharp)),
-				})
-			} else {
-				found = append(found, FoundChord{
-					Weight: 1.0 * weight,
-					Name:   fmt.Sprintf("%s%s", baseNote, chordName),
-				})
+				chord.Weight = 0.5 * weight
+				chord.Bass = bass
+			}
+			if omitted := omissions[i]; len(omitted) > 0 {
+				chord.Omitted = omitted
+				for range omitted {
+					chord.Weight *= omissionWeight
+				}
 			}
+			chord.Name = options.chordName(baseNote, chordType, chord.Omitted, chord.Bass)
+			found = append(found, chord)
 		}
 	}
 
 	return found
 }
+
+// foreignBassWeight multiplies the weight of a slash chord whose bass is not a
+// chord tone.
+const foreignBassWeight = 0.25
+
+// hasChordTone returns true if one of the chords has the root and the interval
+// (in semitones) in its chord type.
+func hasChordTone(chords []FoundChord, root string, interval int) bool {
+	for _, chord := range chords {
+		if chord.Root == root && chord.ChordType.Set().Has(interval) {
+			return true
+		}
+	}
+	return false
+}
+
+// findForeignBass returns the chords of the notes above the bass, with at least
+// three pitch classes, over the bass when it is not one of them. Chords whose
+// root already has a match with the bass as a chord tone are left out: "G C E
+// Bb" is C7/G, not C/G.
+func findForeignBass(notes []*note.Note, names spelling, weight float64, matches []FoundChord, options DetectOptions) []FoundChord {
+	found := make([]FoundChord, 0)
+	if !options.ForeignBass || len(notes) == 0 || notes[0].Class == note.Nil {
+		return found
+	}
+	bassChroma := int(notes[0].Class) - 1
+	bass := names[bassChroma]
+	upper := pcset.NotesToSet(notes).Difference(pcset.SetOf(bassChroma))
+	if upper.Len() < 3 {
+		return found
+	}
+
+	for root, mode := range upper.Rotations() {
+		if !mode.Has(0) || hasChordTone(matches, names[root], (bassChroma-root+12)%12) {
+			continue
+		}
+		chordTypes, omissions := matchChordTypes(mode.Chroma(), options)
+		for i, chordType := range chordTypes {
+			chord := FoundChord{
+				Weight:      foreignBassWeight * weight,
+				Root:        names[root],
+				Bass:        bass,
+				ChordType:   chordType,
+				Quality:     chordType.Quality,
+				ForeignBass: true,
+			}
+			if omitted := omissions[i]; len(omitted) > 0 {
+				chord.Omitted = omitted
+				for range omitted {
+					chord.Weight *= omissionWeight
+				}
+			}
+			chord.Name = options.chordName(names[root], chordType, chord.Omitted, bass)
+			found = append(found, chord)
+		}
+	}
+	return found
+}
@@ -4,6 +4,8 @@ package detector
 import (
 	"testing"
 
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+	"github.com/Golevka2001/go-chord-detector/pitch"
 	"github.com/go-music-theory/music-theory/note"
 	"github.com/stretchr/testify/assert"
 )
@@ -77,6 +79,194 @@ func TestAssumePerfectFifth(t *testing.T) {
 	assert.True(t, hasDm7b5 && hasFm6, "Should detect Dm7b5 and Fm6/D, got: %v", result)
 }
 
+func TestDetectFilters(t *testing.T) {
+	notes := createNotes([]string{"G", "C", "D"})
+	assert.Equal(t, []string{"Gsus4", "Csus2/G"}, Detect(notes))
+	assert.Equal(t, []string{"Gsus4"}, DetectWithOptions(notes, DetectOptions{Deny: []string{"sus2"}}))
+	assert.Equal(t, []string{"Csus2/G"}, DetectWithOptions(notes, DetectOptions{Allow: []string{"suspended second"}}))
+	assert.Empty(t, DetectWithOptions(notes, DetectOptions{ExcludeTags: []string{"sus"}}))
+
+	notes = createNotes([]string{"C", "E", "G", "A"})
+	assert.Equal(t, []string{"C6", "Am7/C"}, Detect(notes))
+	assert.Equal(t, []string{"Am7/C"}, DetectWithOptions(notes, DetectOptions{Qualities: []chordtype.ChordQuality{chordtype.Minor}}))
+	assert.Equal(t, []string{"C6"}, DetectWithOptions(notes, DetectOptions{Tags: []string{"tetrad", "added"}}))
+	assert.Empty(t, DetectWithOptions(notes, DetectOptions{MaxNotes: 3}))
+
+	notes = createNotes([]string{"D", "F", "C"})
+	result := DetectWithOptions(notes, DetectOptions{AssumePerfectFifth: true, Tags: []string{"jazz"}})
+	assert.Equal(t, []string{"Dm7"}, result)
+}
+
+func TestDetectStyle(t *testing.T) {
+	notes := createNotes([]string{"D", "F", "Ab", "C"})
+	assert.Equal(t, []string{"Dø7", "F-6/D"}, DetectWithOptions(notes, DetectOptions{Style: chordtype.Jazz}))
+	assert.Equal(t, []string{"D-7(b5)", "F-6/D"}, DetectWithOptions(notes, DetectOptions{Style: chordtype.Berklee}))
+	assert.Equal(t, []string{"D half-diminished", "F minor sixth over D"}, DetectWithOptions(notes, DetectOptions{Style: chordtype.Classical}))
+
+	found := DetectChords(createNotes([]string{"C", "E", "G", "B"}), DetectOptions{Style: chordtype.Jazz})
+	assert.Equal(t, "CΔ7", found[0].Name)
+	assert.Equal(t, "C", found[0].Root)
+}
+
+func TestDetectOmissions(t *testing.T) {
+	notes := createNotes([]string{"C", "E", "Bb", "D", "A"})
+	assert.Equal(t, []string{"C13no5", "A#M9b5/C"}, Detect(notes))
+
+	// The dictionary names these notes, so no chord type is assumed.
+	assert.Equal(t, []string{"C13no5", "A#M9b5/C"}, DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true}}))
+
+	notes = createNotes([]string{"C", "E", "Bb", "A"})
+	assert.Empty(t, Detect(notes))
+	result := DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true}})
+	assert.Contains(t, result, "C13 (no 5, no 9)")
+	result = DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true, Max: 1}})
+	assert.NotContains(t, result, "C13 (no 5, no 9)")
+	result = DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Intervals: []string{"5P"}}})
+	assert.Equal(t, []string{"C7add6 (no 5)"}, result)
+
+	// The omissions come before the bass.
+	notes = createNotes([]string{"E", "C", "B"})
+	assert.Equal(t, []string{"Cmaj7 (no 5)/E"}, DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true}}))
+	notes = createNotes([]string{"E", "C", "Bb", "A"})
+	result = DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true}, Style: chordtype.Classical})
+	assert.Contains(t, result, "C dominant thirteenth (no 5, no 9) over E")
+
+	notes = createNotes([]string{"D", "F", "C"})
+	assert.Equal(t, []string{"Dm7 (no 5)"}, DetectWithOptions(notes, DetectOptions{Omit: OmitPolicy{Optional: true}}))
+	// Triads have no optional intervals.
+	assert.Empty(t, DetectWithOptions(createNotes([]string{"C", "E"}), DetectOptions{Omit: OmitPolicy{Optional: true}}))
+}
+
+func TestDetectRootless(t *testing.T) {
+	notes := createNotes([]string{"E", "G", "B", "D"})
+	assert.Equal(t, []string{"Em7", "G6/E"}, Detect(notes))
+
+	found := DetectChords(notes, DetectOptions{Rootless: RootlessOptions{Enabled: true}})
+	assert.Len(t, found, 5)
+	assert.Equal(t, "Em7", found[0].Name)
+	assert.False(t, found[0].Rootless)
+	assert.Equal(t, "G6/E", found[1].Name)
+	assert.Equal(t, "Cmaj9 (no root)", found[2].Name)
+	assert.True(t, found[2].Rootless)
+	assert.Equal(t, "C", found[2].Root)
+	assert.Equal(t, "", found[2].Bass)
+	assert.Equal(t, []string{"1P"}, found[2].Omitted)
+	assert.Equal(t, 1.0, found[2].Weight)
+
+	// The bass favours the rootless chords with the same root.
+	found = DetectChords(notes, DetectOptions{Rootless: RootlessOptions{Enabled: true, Bass: "A"}})
+	assert.Equal(t, "A11 (no root)", found[2].Name)
+	assert.Equal(t, 1.0, found[2].Weight)
+	assert.Equal(t, "Cmaj9 (no root)", found[4].Name)
+	assert.Equal(t, 0.5, found[4].Weight)
+
+	// The key spells the root and favours the roots of its scale.
+	notes = createNotes([]string{"D", "F", "A", "C"})
+	result := DetectWithOptions(notes, DetectOptions{Rootless: RootlessOptions{Enabled: true}})
+	assert.Contains(t, result, "A#maj9 (no root)")
+	scale := []string{"Bb", "C", "D", "Eb", "F", "G", "A"}
+	found = DetectChords(notes, DetectOptions{Rootless: RootlessOptions{Enabled: true, Scale: scale}})
+	assert.Equal(t, "Dm7", found[0].Name)
+	result = nil
+	for _, chord := range found {
+		if chord.Rootless && chord.Weight == 1.0 {
+			result = append(result, chord.Name)
+		}
+	}
+	assert.Contains(t, result, "Bbmaj9 (no root)")
+	assert.NotContains(t, result, "A#maj9 (no root)")
+
+	// Other tones can be left out with the omission policy.
+	result = DetectWithOptions(createNotes([]string{"E", "B", "D"}), DetectOptions{
+		Rootless: RootlessOptions{Enabled: true, Bass: "C"},
+		Omit:     OmitPolicy{Optional: true},
+	})
+	assert.Contains(t, result, "Cmaj9 (no root, no 5)")
+
+	assert.Empty(t, DetectWithOptions(createNotes([]string{"E", "G"}), DetectOptions{Rootless: RootlessOptions{Enabled: true}}))
+}
+
+func TestDetectPolychords(t *testing.T) {
+	notes := createNotes([]string{"C", "E", "G", "Bb", "D", "F#", "A"})
+	assert.Equal(t, []string{"C13#11"}, Detect(notes))
+
+	found := DetectPolychords(notes, DetectOptions{Style: chordtype.Jazz})
+	assert.Equal(t, "D|C7", found[0].Name)
+	assert.Equal(t, "D/C7", found[0].Slash)
+	assert.Equal(t, "D", found[0].Upper.Root)
+	assert.Equal(t, "C7", found[0].Lower.Name)
+	assert.Equal(t, chordtype.Dominant, found[0].Lower.Quality)
+	assert.Equal(t, 1.0, found[0].Weight)
+	for _, polychord := range found[1:] {
+		assert.Less(t, polychord.Weight, 1.0, polychord.Name)
+	}
+
+	// The chords of a polychord have all their notes.
+	all := DetectPolychords(notes, DetectOptions{})
+	withForeignBass := DetectPolychords(notes, DetectOptions{ForeignBass: true, Rootless: RootlessOptions{Enabled: true}})
+	assert.Equal(t, all, withForeignBass)
+	for _, polychord := range withForeignBass {
+		assert.False(t, polychord.Lower.ForeignBass || polychord.Lower.Rootless, polychord.Name)
+		assert.False(t, polychord.Upper.ForeignBass || polychord.Upper.Rootless, polychord.Name)
+	}
+
+	found = DetectPolychords(createNotes([]string{"C", "E", "G", "D", "F#", "A"}), DetectOptions{})
+	assert.Equal(t, "DM|CM", found[0].Name)
+
+	// With octaves, the upper chord is the highest notes.
+	notes = []*note.Note{
+		{Class: note.C, Octave: 3}, {Class: note.As, Octave: 3}, {Class: note.E, Octave: 4},
+		{Class: note.A, Octave: 4}, {Class: note.D, Octave: 5}, {Class: note.Fs, Octave: 5},
+	}
+	result := make([]string, 0)
+	for _, polychord := range DetectPolychords(notes, DetectOptions{Style: chordtype.Pop}) {
+		result = append(result, polychord.Slash)
+	}
+	assert.Equal(t, []string{"D/C7no5", "F#m#5/C7no5"}, result)
+
+	found = DetectPitchPolychords(createPitches([]string{"Db", "F", "Ab", "Cb", "Eb", "G", "Bb"}), DetectOptions{Style: chordtype.Jazz})
+	assert.Equal(t, "Eb|Db7", found[0].Name)
+
+	assert.Empty(t, DetectPolychords(createNotes([]string{"C", "E", "G", "B"}), DetectOptions{}))
+	assert.Empty(t, DetectPolychords(nil, DetectOptions{}))
+}
+
+func TestDetectForeignBass(t *testing.T) {
+	notes := createNotes([]string{"F#", "C", "E", "G"})
+	assert.Empty(t, Detect(notes))
+
+	found := DetectChords(notes, DetectOptions{ForeignBass: true, Style: chordtype.Pop})
+	assert.Equal(t, []string{"C/F#", "Em#5/F#"}, chordNames(found))
+	assert.True(t, found[0].ForeignBass)
+	assert.Equal(t, "C", found[0].Root)
+	assert.Equal(t, "F#", found[0].Bass)
+	assert.Equal(t, "major", found[0].ChordType.Name)
+	assert.Equal(t, 0.25, found[0].Weight)
+
+	// A bass that is a chord tone of a chord with the same root is not foreign.
+	notes = createNotes([]string{"D", "C", "E", "G"})
+	result := DetectWithOptions(notes, DetectOptions{ForeignBass: true, Style: chordtype.Pop})
+	assert.Equal(t, []string{"Cadd9/D", "Em7#5/D"}, result)
+	result = DetectWithOptions(createNotes([]string{"G", "C", "E", "A#"}), DetectOptions{ForeignBass: true})
+	assert.Equal(t, []string{"C7/G"}, result)
+	result = DetectWithOptions(createNotes([]string{"D", "F", "Ab", "C"}), DetectOptions{ForeignBass: true})
+	assert.Equal(t, []string{"Dm7b5", "Fm6/D"}, result)
+
+	result = DetectPitchesWithOptions(createPitches([]string{"Gb", "C", "E", "G"}), DetectOptions{ForeignBass: true})
+	assert.Contains(t, result, "CM/Gb")
+
+	// The notes above the bass must be at least a triad.
+	assert.Equal(t, Detect(createNotes([]string{"E", "C", "G"})), DetectWithOptions(createNotes([]string{"E", "C", "G"}), DetectOptions{ForeignBass: true}))
+}
+
+func chordNames(chords []FoundChord) []string {
+	result := make([]string, len(chords))
+	for i, chord := range chords {
+		result[i] = chord.Name
+	}
+	return result
+}
+
 func TestDetectAug(t *testing.T) {
 	notes := createNotes([]string{"C", "E", "G#"})
 	result := Detect(notes)
@@ -102,3 +292,81 @@ func TestEdgeCases(t *testing.T) {
 	result := Detect([]*note.Note{})
 	assert.Empty(t, result, "Should return empty slice for empty input")
 }
+
+func createPitches(noteNames []string) []pitch.Note {
+	var notes []pitch.Note
+	for _, name := range noteNames {
+		notes = append(notes, pitch.MustParseNote(name))
+	}
+	return notes
+}
+
+func TestDetectPitches(t *testing.T) {
+	result := DetectPitches(createPitches([]string{"Db", "F", "Ab"}))
+	assert.Equal(t, []string{"DbM", "Fm#5/Db"}, result)
+
+	result = DetectPitches(createPitches([]string{"F", "Ab", "Cb", "Eb"}))
+	assert.Contains(t, result, "Fm7b5")
+	assert.Contains(t, result, "Abm6/F")
+
+	// Notes spelled like note.Class are detected like Detect does.
+	names := []string{"D", "F#", "A", "C"}
+	assert.Equal(t, Detect(createNotes(names)), DetectPitches(createPitches(names)))
+
+	result = DetectPitchesWithOptions(createPitches([]string{"Eb", "Gb", "Db"}), DetectOptions{AssumePerfectFifth: true})
+	assert.Contains(t, result, "Ebm7")
+
+	assert.Empty(t, DetectPitches(nil))
+}
+
+func TestFoundChordNotes(t *testing.T) {
+	found := DetectPitchChords(createPitches([]string{"F", "Ab", "Cb", "Eb"}), DetectOptions{Style: chordtype.Classical})
+	assert.Equal(t, "F half-diminished", found[0].Name)
+	assert.Equal(t, []string{"F", "Ab", "Cb", "Eb"}, found[0].NoteNames())
+	assert.Equal(t, "Ab minor sixth over F", found[1].Name)
+	assert.Equal(t, []string{"F", "Ab", "Cb", "Eb"}, found[1].NoteNames())
+
+	found = DetectChords(createNotes([]string{"E", "C", "Bb", "A"}), DetectOptions{Omit: OmitPolicy{Optional: true}, Style: chordtype.Jazz})
+	assert.Contains(t, chordNames(found), "C13 (no 5, no 9)/E")
+	for _, chord := range found {
+		if chord.Name == "C13 (no 5, no 9)/E" {
+			assert.Equal(t, []string{"E", "Bb", "A", "C"}, chord.NoteNames())
+		}
+	}
+
+	found = DetectChords(createNotes([]string{"E", "G", "B", "D"}), DetectOptions{Rootless: RootlessOptions{Enabled: true}, Style: chordtype.Jazz})
+	assert.Equal(t, "CΔ9 (no root)", found[2].Name)
+	assert.Equal(t, []string{"E", "G", "B", "D"}, found[2].NoteNames())
+
+	found = DetectChords(createNotes([]string{"F#", "C", "E", "G"}), DetectOptions{ForeignBass: true})
+	assert.Equal(t, "CM/F#", found[0].Name)
+	assert.Equal(t, []string{"F#", "C", "E", "G"}, found[0].NoteNames())
+
+	assert.Nil(t, FoundChord{}.Notes())
+}
+
+func TestDetectChords(t *testing.T) {
+	found := DetectChords(createNotes([]string{"D", "F", "Ab", "C"}), DetectOptions{})
+	assert.Len(t, found, 2)
+	assert.Equal(t, "Dm7b5", found[0].Name)
+	assert.Equal(t, "D", found[0].Root)
+	assert.Equal(t, "", found[0].Bass)
+	assert.Equal(t, "half-diminished", found[0].ChordType.Name)
+	assert.Equal(t, chordtype.HalfDiminished, found[0].Quality)
+	assert.Equal(t, 1.0, found[0].Weight)
+
+	assert.Equal(t, "Fm6/D", found[1].Name)
+	assert.Equal(t, "F", found[1].Root)
+	assert.Equal(t, "D", found[1].Bass)
+	assert.Equal(t, chordtype.Minor, found[1].Quality)
+	assert.Equal(t, 0.5, found[1].Weight)
+
+	found = DetectPitchChords(createPitches([]string{"Bb", "D", "F", "Ab"}), DetectOptions{})
+	assert.Equal(t, "Bb7", found[0].Name)
+	assert.Equal(t, chordtype.Dominant, found[0].Quality)
+
+	found = DetectChords(createNotes([]string{"C", "G"}), DetectOptions{})
+	assert.Equal(t, chordtype.Power, found[0].Quality)
+
+	assert.Empty(t, DetectChords(nil, DetectOptions{}))
+}
@@ -1,15 +1,15 @@
 module github.com/Golevka2001/go-chord-detector
 
-go 1.17
+go 1.18
 
 require (
 	github.com/go-music-theory/music-theory v0.0.4
 	github.com/stretchr/testify v1.10.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	gopkg.in/stretchr/testify.v1 v1.2.2 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,433 @@
+// Decode iReal Pro chart links ("irealb://..." and the older "irealbook://...").
+// Reference: https://github.com/pianosnake/ireal-reader
+package ireal
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+)
+
+// Playlist is the content of an iReal link: one or more songs and, when the
+// link holds several songs, the name of the playlist.
+type Playlist struct {
+	Name  string
+	Songs []Song
+}
+
+// Song is a decoded iReal chart.
+//
+// Key is written as a chord symbol tonic ("Eb", "Cm"). Bars are in the order
+// they are written in the chart, repeats are marked but not unrolled.
+type Song struct {
+	Title     string
+	Composer  string
+	Style     string
+	Key       string
+	Transpose int
+	CompStyle string
+	BPM       int
+	Repeats   int
+	Bars      []Bar
+}
+
+// Bar is a measure of the chart.
+//
+// Section ("A", "B", "i" for intro, "V" for verse), TimeSignature ("4/4") and
+// Comment are only set on the bar where they are written. Ending is 1, 2, 3...
+// for the first bar of a numbered ending, 0 otherwise.
+type Bar struct {
+	Chords        []Chord
+	Section       string
+	TimeSignature string
+	Ending        int
+	RepeatStart   bool
+	RepeatEnd     bool
+	Comment       string
+}
+
+// Chord is a chord as written in the chart.
+//
+// Raw is the iReal notation ("C-7/Bb", "F^7"), Chord is the same chord as a
+// regular chord symbol ("Cm7/Bb", "Fmaj7") parsed with the chord type dictionary.
+// A "no chord" mark has Raw "n" and an empty Chord. Alternate is the optional
+// small chord written above ("Db7" for "C7(Db7)").
+type Chord struct {
+	Raw       string
+	Chord     chord.Chord
+	Alternate string
+}
+
+var ErrNotIReal = errors.New("ireal: not an iReal Pro link")
+
+const (
+	scheme     = "irealb://"
+	oldScheme  = "irealbook://"
+	musicMagic = "1r34LbKcu7"
+)
+
+// Parse decodes an iReal Pro link.
+func Parse(link string) (Playlist, error) {
+	link = strings.TrimSpace(link)
+
+	var body string
+	old := false
+	switch {
+	case strings.HasPrefix(link, scheme):
+		body = link[len(scheme):]
+	case strings.HasPrefix(link, oldScheme):
+		body = link[len(oldScheme):]
+		old = true
+	default:
+		return Playlist{}, ErrNotIReal
+	}
+
+	decoded, err := url.PathUnescape(body)
+	if err != nil {
+		return Playlist{}, fmt.Errorf("ireal: %w", err)
+	}
+
+	parts := strings.Split(decoded, "===")
+	playlist := Playlist{}
+	if len(parts) > 1 {
+		playlist.Name = parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		var song Song
+		var err error
+		if old {
+			song, err = parseOldSong(part)
+		} else {
+			song, err = parseSong(part)
+		}
+		if err != nil {
+			return Playlist{}, err
+		}
+		playlist.Songs = append(playlist.Songs, song)
+	}
+
+	if len(playlist.Songs) == 0 {
+		return Playlist{}, errors.New("ireal: no song in link")
+	}
+	return playlist, nil
+}
+
+// Format: Title=Composer==Style=Key=Transpose=Music=CompStyle=BPM=Repeats
+func parseSong(data string) (Song, error) {
+	fields := strings.Split(data, "=")
+	if len(fields) < 7 {
+		return Song{}, fmt.Errorf("ireal: song %q has %d fields, want at least 7", fields[0], len(fields))
+	}
+
+	music := fields[6]
+	if !strings.HasPrefix(music, musicMagic) {
+		return Song{}, fmt.Errorf("ireal: song %q has no music data", fields[0])
+	}
+
+	song := Song{
+		Title:     fields[0],
+		Composer:  fields[1],
+		Style:     fields[3],
+		Key:       parseKey(fields[4]),
+		Transpose: atoi(fields[5]),
+		Bars:      parseMusic(Unscramble(music[len(musicMagic):])),
+	}
+	if len(fields) > 7 {
+		song.CompStyle = fields[7]
+	}
+	if len(fields) > 8 {
+		song.BPM = atoi(fields[8])
+	}
+	if len(fields) > 9 {
+		song.Repeats = atoi(fields[9])
+	}
+	return song, nil
+}
+
+// Format: Title=Composer=Style=Key=n=Music
+func parseOldSong(data string) (Song, error) {
+	fields := strings.Split(data, "=")
+	if len(fields) < 6 {
+		return Song{}, fmt.Errorf("ireal: song %q has %d fields, want at least 6", fields[0], len(fields))
+	}
+
+	return Song{
+		Title:    fields[0],
+		Composer: fields[1],
+		Style:    fields[2],
+		Key:      parseKey(fields[3]),
+		Bars:     parseMusic(fields[5]),
+	}, nil
+}
+
+func parseKey(key string) string {
+	if strings.HasSuffix(key, "-") {
+		return strings.TrimSuffix(key, "-") + "m"
+	}
+	return key
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(s))
+	return n
+}
+
+// Unscramble reverses the obfuscation of the music data of an "irealb://" link
+// (the part after the "1r34LbKcu7" marker) and expands its shorthands.
+//
+// The data is scrambled in blocks of 50 characters, except the last block and
+// a block followed by less than 2 characters.
+func Unscramble(s string) string {
+	var builder strings.Builder
+	for len(s) > 50 {
+		block := s[:50]
+		s = s[50:]
+		if len(s) < 2 {
+			builder.WriteString(block)
+		} else {
+			builder.WriteString(obfusc50(block))
+		}
+	}
+	builder.WriteString(s)
+
+	r := builder.String()
+	r = strings.ReplaceAll(r, "Kcl", "| x")
+	r = strings.ReplaceAll(r, "LZ", " |")
+	r = strings.ReplaceAll(r, "XyQ", "   ")
+	return r
+}
+
+// obfusc50 swaps the characters 0-4 and 10-23 with their mirror in the block.
+// It is its own inverse.
+func obfusc50(s string) string {
+	r := []byte(s)
+	for i := 0; i < 5; i++ {
+		r[49-i], r[i] = s[i], s[49-i]
+	}
+	for i := 10; i < 24; i++ {
+		r[49-i], r[i] = s[i], s[49-i]
+	}
+	return string(r)
+}
+
+// Chords returns all the chords of the song in order, skipping "no chord" marks.
+func (s Song) Chords() []chord.Chord {
+	var chords []chord.Chord
+	for _, bar := range s.Bars {
+		for _, c := range bar.Chords {
+			if !c.Chord.Empty {
+				chords = append(chords, c.Chord)
+			}
+		}
+	}
+	return chords
+}
+
+// Unknown returns the chords whose quality is not in the chord type dictionary.
+func (s Song) Unknown() []string {
+	unknown := make([]string, 0)
+	for _, bar := range s.Bars {
+		for _, c := range bar.Chords {
+			if !c.Chord.Empty && !c.Chord.Known() {
+				unknown = append(unknown, c.Raw)
+			}
+		}
+	}
+	return unknown
+}
+
+// iReal qualities that mean something else, or are missing, in the chord type dictionary.
+var qualities = map[string]string{
+	"^":   "maj7",
+	"h":   "m7b5",
+	"h9":  "m9b5",
+	"sus": "sus4",
+}
+
+// Root (or "W" for an invisible root), quality, bass and alternate chord.
+var chordRegex = regexp.MustCompile(`^([A-G][b#]?|W)([-+^0-9b#hosuadlt]*)(?:/([A-G][b#]?))?(?:\(([^)]*)\))?`)
+
+// ParseChord converts a chord in iReal notation ("C-7", "Bb^7/D") to a chord symbol.
+//
+// A chord with an invisible root ("W/C") is a bass change under the previous
+// chord: it is returned empty, with only its Bass and Symbol ("/C") set. Songs
+// replace it with the previous chord over that bass.
+func ParseChord(raw string) chord.Chord {
+	matches := chordRegex.FindStringSubmatch(raw)
+	if matches == nil {
+		return chord.NoChord
+	}
+	if matches[1] == "W" {
+		c := chord.NoChord
+		if matches[3] != "" {
+			c.Bass, c.Symbol = matches[3], "/"+matches[3]
+		}
+		return c
+	}
+
+	quality := matches[2]
+	if alias, exists := qualities[quality]; exists {
+		quality = alias
+	}
+
+	bass := ""
+	if matches[3] != "" {
+		bass = "/" + matches[3]
+	}
+
+	c := chord.Get(matches[1] + quality + bass)
+	if c.Known() && len(c.ChordType.Aliases) > 0 {
+		// Write known chords with the usual symbol of their type, unless it would be
+		// read as part of the root ("b9sus" after "B" reads "Bb 9sus").
+		if alias := c.ChordType.Aliases[0]; !strings.HasPrefix(alias, "b") && !strings.HasPrefix(alias, "#") {
+			c = chord.Get(matches[1] + alias + bass)
+		}
+	}
+	return c
+}
+
+type parser struct {
+	bars    []Bar
+	current Bar
+	started bool
+	// Set when an "r" (repeat the last two bars) was found, so the next bar is filled too.
+	repeatNext []Chord
+}
+
+func parseMusic(music string) []Bar {
+	p := &parser{}
+
+	for i := 0; i < len(music); {
+		c := music[i]
+		switch {
+		case c == 'T' && i+2 < len(music):
+			p.current.TimeSignature = timeSignature(music[i+1 : i+3])
+			i += 3
+		case c == '*' && i+1 < len(music):
+			p.current.Section = string(music[i+1])
+			i += 2
+		case c == 'N' && i+1 < len(music) && music[i+1] >= '0' && music[i+1] <= '9':
+			p.current.Ending = int(music[i+1] - '0')
+			i += 2
+		case c == '<':
+			end := strings.IndexByte(music[i:], '>')
+			if end == -1 {
+				end = len(music) - i - 1
+			}
+			p.current.Comment = strings.TrimSpace(music[i+1 : i+end])
+			i += end + 1
+		case c == '{':
+			p.endBar()
+			p.current.RepeatStart = true
+			i++
+		case c == '}':
+			p.current.RepeatEnd = true
+			p.endBar()
+			i++
+		case c == '|' || c == '[' || c == ']' || c == 'Z':
+			p.endBar()
+			i++
+		case c == 'x':
+			if len(p.bars) > 0 {
+				p.current.Chords = append(p.current.Chords, p.bars[len(p.bars)-1].Chords...)
+			}
+			p.started = true
+			i++
+		case c == 'r':
+			if len(p.bars) > 1 {
+				p.current.Chords = append(p.current.Chords, p.bars[len(p.bars)-2].Chords...)
+				p.repeatNext = p.bars[len(p.bars)-1].Chords
+			}
+			p.started = true
+			i++
+		case c == 'n':
+			p.current.Chords = append(p.current.Chords, Chord{Raw: "n", Chord: chord.NoChord})
+			p.started = true
+			i++
+		default:
+			if matches := chordRegex.FindStringSubmatch(music[i:]); matches != nil {
+				raw := matches[0]
+				if matches[4] != "" {
+					raw = strings.TrimSuffix(raw, "("+matches[4]+")")
+				}
+				c := ParseChord(raw)
+				if matches[1] == "W" {
+					c = p.bassChange(c.Bass)
+				}
+				p.current.Chords = append(p.current.Chords, Chord{
+					Raw:       raw,
+					Chord:     c,
+					Alternate: matches[4],
+				})
+				p.started = true
+				i += len(matches[0])
+			} else {
+				// Spaces, commas, slashes ("p"), segno, coda, fermata, spacers and size marks.
+				i++
+			}
+		}
+	}
+	p.endBar()
+
+	return p.bars
+}
+
+// bassChange returns the last chord written before, over a new bass, or an empty
+// chord if there is none.
+func (p *parser) bassChange(bass string) chord.Chord {
+	previous := lastChord(p.current.Chords)
+	for i := len(p.bars) - 1; i >= 0 && previous.Empty; i-- {
+		previous = lastChord(p.bars[i].Chords)
+	}
+	if previous.Empty || bass == "" {
+		return previous
+	}
+	return chord.Get(previous.Tonic + previous.Type + "/" + bass)
+}
+
+// lastChord returns the last chord of a bar that is not empty.
+func lastChord(chords []Chord) chord.Chord {
+	for i := len(chords) - 1; i >= 0; i-- {
+		if !chords[i].Chord.Empty {
+			return chords[i].Chord
+		}
+	}
+	return chord.NoChord
+}
+
+func (p *parser) endBar() {
+	if !p.started && p.repeatNext != nil {
+		p.current.Chords = append(p.current.Chords, p.repeatNext...)
+		p.repeatNext = nil
+		p.started = true
+	}
+	if !p.started {
+		// Nothing was written since the last bar line. Keep the markers for the next bar.
+		if p.current.RepeatEnd && len(p.bars) > 0 {
+			p.bars[len(p.bars)-1].RepeatEnd = true
+			p.current.RepeatEnd = false
+		}
+		return
+	}
+
+	p.bars = append(p.bars, p.current)
+	p.current = Bar{}
+	p.started = false
+}
+
+func timeSignature(digits string) string {
+	if digits == "12" {
+		return "12/8"
+	}
+	return string(digits[0]) + "/" + string(digits[1])
+}
@@ -0,0 +1,167 @@
+package ireal
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The scrambling is its own inverse, so charts can be encoded with Unscramble.
+func link(title string, key string, music string) string {
+	data := title + "=Composer Some==Medium Swing=" + key + "==" + musicMagic + Unscramble(music) + "==160=3"
+	return scheme + url.PathEscape(data)
+}
+
+const music = "{*AT44C^7 |A-7 |D-7 G7 |Kcl|N1Ch7 F7(Db7)}XyQ|N2Eb-7 Ab7 Z" +
+	"[*BD-7XyQ|r|XyQ|n |W/C |B7b9sus |Bbo7 |C7alt Z"
+
+func TestUnscramble(t *testing.T) {
+	s := strings.Repeat("abcdefghij", 12)
+	assert.NotEqual(t, s, Unscramble(s))
+	assert.Equal(t, s, Unscramble(Unscramble(s)))
+	assert.Equal(t, "C |D | x   ", Unscramble("CLZD Kcl"+"XyQ"))
+}
+
+func TestParse(t *testing.T) {
+	playlist, err := Parse(link("Test Tune", "Eb-", music))
+	assert.NoError(t, err)
+	assert.Equal(t, "", playlist.Name)
+	assert.Len(t, playlist.Songs, 1)
+
+	song := playlist.Songs[0]
+	assert.Equal(t, "Test Tune", song.Title)
+	assert.Equal(t, "Composer Some", song.Composer)
+	assert.Equal(t, "Medium Swing", song.Style)
+	assert.Equal(t, "Ebm", song.Key)
+	assert.Equal(t, 160, song.BPM)
+	assert.Equal(t, 3, song.Repeats)
+
+	var symbols [][]string
+	for _, bar := range song.Bars {
+		var chords []string
+		for _, c := range bar.Chords {
+			chords = append(chords, c.Chord.Symbol)
+		}
+		symbols = append(symbols, chords)
+	}
+	assert.Equal(t, [][]string{
+		{"Cmaj7"}, {"Am7"}, {"Dm7", "G7"}, {"Dm7", "G7"}, {"Cm7b5", "F7"}, {"Ebm7", "Ab7"},
+		{"Dm7"}, {"Ebm7", "Ab7"}, {"Dm7"}, {""}, {"Dm7/C"}, {"B7b9sus"}, {"Bbdim7"}, {"C7#5#9"},
+	}, symbols)
+
+	first := song.Bars[0]
+	assert.Equal(t, "A", first.Section)
+	assert.Equal(t, "4/4", first.TimeSignature)
+	assert.True(t, first.RepeatStart)
+
+	ending := song.Bars[4]
+	assert.Equal(t, 1, ending.Ending)
+	assert.True(t, ending.RepeatEnd)
+	assert.Equal(t, "Db7", ending.Chords[1].Alternate)
+	assert.Equal(t, 2, song.Bars[5].Ending)
+	assert.Equal(t, "B", song.Bars[6].Section)
+
+	assert.Equal(t, "minor seventh", song.Bars[1].Chords[0].Chord.ChordType.Name)
+	assert.Equal(t, "dominant seventh", song.Bars[4].Chords[1].Chord.ChordType.Name)
+	assert.Empty(t, song.Unknown())
+	assert.Len(t, song.Chords(), 18)
+
+	// "W/C" keeps the previous chord with a new bass.
+	bassChange := song.Bars[10].Chords[0]
+	assert.Equal(t, "W/C", bassChange.Raw)
+	assert.Equal(t, "D", bassChange.Chord.Tonic)
+	assert.Equal(t, "C", bassChange.Chord.Bass)
+	assert.Equal(t, "minor seventh", bassChange.Chord.ChordType.Name)
+}
+
+// An irealb link as iReal Pro writes it: the music is scrambled in blocks of 50
+// characters before the shorthands ("XyQ", "Kcl", "LZ") are expanded, and all
+// the punctuation is escaped. It was encoded with the scrambling of the
+// reference implementation, not with this package.
+const fixture = "irealb://Test%20Chart%3DDoe%20John%3D%3DMedium%20Swing%3DC%3D%3D1r34LbKcu77A%205b4C%5E7XE%2FW%207%5ECZL7G%207-%7CDQyX7-AZL%20lcKQy%20%7CE-74TA%2A%7B-A%7CQyBF%5E7X%2A%5B%5D%207G%207-DZL7A7%20-EZL7bB%207hF%7CQyAC%5E7X%2A%5B%7D9b7%20D7LZD-7%20G7LZC6%20n%20Z%3DJazz-Medium%20Swing%3D140%3D3"
+
+func TestParseFixture(t *testing.T) {
+	playlist, err := Parse(fixture)
+	assert.NoError(t, err)
+	assert.Len(t, playlist.Songs, 1)
+
+	song := playlist.Songs[0]
+	assert.Equal(t, "Test Chart", song.Title)
+	assert.Equal(t, "Doe John", song.Composer)
+	assert.Equal(t, "Medium Swing", song.Style)
+	assert.Equal(t, "C", song.Key)
+	assert.Equal(t, "Jazz-Medium Swing", song.CompStyle)
+	assert.Equal(t, 140, song.BPM)
+	assert.Equal(t, 3, song.Repeats)
+
+	var symbols [][]string
+	for _, bar := range song.Bars {
+		var chords []string
+		for _, c := range bar.Chords {
+			chords = append(chords, c.Chord.Symbol)
+		}
+		symbols = append(symbols, chords)
+	}
+	assert.Equal(t, [][]string{
+		{"Cmaj7"}, {"Cmaj7"}, {"Am7"}, {"Dm7", "G7"}, {"Cmaj7", "Cmaj7/E"}, {"Em7b5", "A7b9"},
+		{"Fmaj7"}, {"Fm7b5", "Bb7"}, {"Em7", "A7"}, {"Dm7", "G7"},
+		{"Cmaj7"}, {"Am7", "D7"}, {"Dm7", "G7"}, {"C6", ""},
+	}, symbols)
+	assert.Equal(t, "A", song.Bars[0].Section)
+	assert.Equal(t, "4/4", song.Bars[0].TimeSignature)
+	assert.True(t, song.Bars[5].RepeatEnd)
+	assert.Equal(t, "B", song.Bars[6].Section)
+	assert.Equal(t, "A", song.Bars[10].Section)
+	assert.Empty(t, song.Unknown())
+}
+
+func TestParsePlaylist(t *testing.T) {
+	first := strings.TrimPrefix(link("One", "C", "[C |G7 Z"), scheme)
+	second := strings.TrimPrefix(link("Two", "F", "[F |C7 Z"), scheme)
+	playlist, err := Parse(scheme + first + "===" + second + "===My%20List")
+	assert.NoError(t, err)
+	assert.Equal(t, "My List", playlist.Name)
+	assert.Len(t, playlist.Songs, 2)
+	assert.Equal(t, "Two", playlist.Songs[1].Title)
+}
+
+func TestParseChord(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected string
+	}{
+		{"C^7", "major seventh"},
+		{"C^", "major seventh"},
+		{"C-", "minor"},
+		{"Ch", "half-diminished"},
+		{"Ch7", "half-diminished"},
+		{"Co7", "diminished seventh"},
+		{"C+", "augmented"},
+		{"Csus", "suspended fourth"},
+		{"C7sus", "suspended fourth seventh"},
+		{"C-^7", "minor/major seventh"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, ParseChord(tc.raw).ChordType.Name, "Chord: %s", tc.raw)
+	}
+
+	c := ParseChord("Bb-7/Ab")
+	assert.Equal(t, "Bb", c.Tonic)
+	assert.Equal(t, "Ab", c.Bass)
+
+	assert.False(t, ParseChord("C7susadd3").Known())
+	c = ParseChord("W/C")
+	assert.True(t, c.Empty)
+	assert.Equal(t, "C", c.Bass)
+	assert.Equal(t, "/C", c.Symbol)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("https://example.com")
+	assert.Equal(t, ErrNotIReal, err)
+
+	_, err = Parse(scheme + "Title=Composer")
+	assert.Error(t, err)
+}
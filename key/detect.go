@@ -0,0 +1,211 @@
+package key
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Histogram is the weight of each pitch class, index 0 is C.
+type Histogram [12]float64
+
+// Estimate is a candidate key.
+//
+// Correlation is the Pearson correlation (-1 to 1) between the histogram and the
+// profile of the key. Confidence turns the correlations of the 24 keys into
+// weights that add up to 1.
+type Estimate struct {
+	Key         Key
+	Correlation float64
+	Confidence  float64
+}
+
+// Window is the result of the detection on a part of a timeline.
+type Window struct {
+	Start     float64
+	End       float64
+	Estimates []Estimate
+}
+
+// Krumhansl-Kessler key profiles, starting from the tonic.
+var (
+	majorProfile = [12]float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorProfile = [12]float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// Names of the tonics of the keys, by pitch class.
+var (
+	majorTonics = []string{"C", "Db", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+	minorTonics = []string{"C", "C#", "D", "Eb", "E", "F", "F#", "G", "G#", "A", "Bb", "B"}
+)
+
+// How much the confidence favours the best correlations. With 20, a key whose
+// correlation is 0.05 higher than another one is e (2.7) times more likely.
+const sharpness = 20
+
+// Detect ranks the 24 major and minor keys by correlation with the histogram,
+// with the Krumhansl-Schmuckler algorithm.
+//
+// Returns an empty list if the histogram is empty.
+func Detect(histogram Histogram) []Estimate {
+	if histogram.total() == 0 {
+		return make([]Estimate, 0)
+	}
+
+	estimates := make([]Estimate, 0, 24)
+	for tonic := 0; tonic < 12; tonic++ {
+		estimates = append(estimates,
+			Estimate{
+				Key:         Key{Tonic: majorTonics[tonic], Mode: Major},
+				Correlation: correlation(histogram, majorProfile, tonic),
+			},
+			Estimate{
+				Key:         Key{Tonic: minorTonics[tonic], Mode: Minor},
+				Correlation: correlation(histogram, minorProfile, tonic),
+			},
+		)
+	}
+
+	sum := 0.0
+	for _, estimate := range estimates {
+		sum += math.Exp(sharpness * estimate.Correlation)
+	}
+	for i := range estimates {
+		estimates[i].Confidence = math.Exp(sharpness*estimates[i].Correlation) / sum
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		return estimates[i].Correlation > estimates[j].Correlation
+	})
+	return estimates
+}
+
+// DetectNotes ranks the keys for a list of notes, weighted by their duration.
+func DetectNotes(notes []*note.Note) []Estimate {
+	return Detect(NotesHistogram(notes))
+}
+
+// DetectPitches ranks the keys for a list of spelled notes, each one counted once.
+func DetectPitches(notes []pitch.Note) []Estimate {
+	return DetectNotes(pitch.ToNotes(notes))
+}
+
+// DetectChords ranks the keys for a sequence of chords.
+func DetectChords(chords []chord.Chord) []Estimate {
+	return Detect(ChordsHistogram(chords))
+}
+
+// DetectTimeline tracks the key over time. Notes are placed with their Position
+// and Duration; each window of the given size, every hop, is detected on its own
+// with the part of the notes it overlaps.
+func DetectTimeline(notes []*note.Note, size float64, hop float64) []Window {
+	windows := make([]Window, 0)
+	if len(notes) == 0 || size <= 0 || hop <= 0 {
+		return windows
+	}
+
+	start, end := math.Inf(1), math.Inf(-1)
+	for _, n := range notes {
+		if n == nil {
+			continue
+		}
+		start = math.Min(start, n.Position)
+		end = math.Max(end, n.Position+duration(n))
+	}
+
+	for from := start; from < end; from += hop {
+		to := from + size
+		histogram := Histogram{}
+		for _, n := range notes {
+			if n == nil || n.Class == note.Nil {
+				continue
+			}
+			overlap := math.Min(to, n.Position+duration(n)) - math.Max(from, n.Position)
+			if overlap > 0 {
+				histogram[int(n.Class)-1] += overlap
+			}
+		}
+		windows = append(windows, Window{Start: from, End: to, Estimates: Detect(histogram)})
+	}
+	return windows
+}
+
+// NotesHistogram sums the duration of the notes of each pitch class.
+// Notes without a duration count as 1.
+func NotesHistogram(notes []*note.Note) Histogram {
+	histogram := Histogram{}
+	for _, n := range notes {
+		if n != nil && n.Class != note.Nil {
+			histogram[int(n.Class)-1] += duration(n)
+		}
+	}
+	return histogram
+}
+
+// ChordsHistogram counts the chord tones of a sequence of chords. The root and
+// the bass are counted one more time, as they are the most stable notes of a chord.
+func ChordsHistogram(chords []chord.Chord) Histogram {
+	histogram := Histogram{}
+	for _, c := range chords {
+		root, ok := chord.NoteFifths(c.Tonic)
+		if !c.Known() || !ok {
+			continue
+		}
+		for _, name := range c.ChordType.Intervals {
+			interval := pitchinterval.Parse(name)
+			if !interval.Empty {
+				histogram[fifthsToChroma(root+interval.Fifths())]++
+			}
+		}
+		histogram[fifthsToChroma(root)]++
+		if bass, ok := chord.NoteFifths(c.Bass); ok {
+			histogram[fifthsToChroma(bass)]++
+		}
+	}
+	return histogram
+}
+
+func (h Histogram) total() float64 {
+	total := 0.0
+	for _, weight := range h {
+		total += weight
+	}
+	return total
+}
+
+// correlation returns the Pearson correlation of the histogram and the profile rotated to the tonic.
+func correlation(histogram Histogram, profile [12]float64, tonic int) float64 {
+	var meanH, meanP float64
+	for i := 0; i < 12; i++ {
+		meanH += histogram[i] / 12
+		meanP += profile[i] / 12
+	}
+
+	var covariance, varianceH, varianceP float64
+	for i := 0; i < 12; i++ {
+		h := histogram[(tonic+i)%12] - meanH
+		p := profile[i] - meanP
+		covariance += h * p
+		varianceH += h * h
+		varianceP += p * p
+	}
+	if varianceH == 0 {
+		return 0
+	}
+	return covariance / math.Sqrt(varianceH*varianceP)
+}
+
+func duration(n *note.Note) float64 {
+	if n.Duration <= 0 {
+		return 1
+	}
+	return n.Duration
+}
+
+func fifthsToChroma(fifths int) int {
+	return pitch.Coordinates{Fifths: fifths}.Chroma()
+}
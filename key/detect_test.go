@@ -0,0 +1,87 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+func scaleNotes(names []string, position float64) []*note.Note {
+	notes := make([]*note.Note, len(names))
+	for i, name := range names {
+		notes[i] = &note.Note{Class: note.ClassNamed(name), Position: position + float64(i), Duration: 1}
+	}
+	return notes
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("from notes", func(t *testing.T) {
+		estimates := DetectNotes(scaleNotes([]string{"C", "D", "E", "F", "G", "A", "B", "C", "G", "E", "C"}, 0))
+		assert.Len(t, estimates, 24)
+		assert.Equal(t, "C major", estimates[0].Key.Name())
+		assert.Greater(t, estimates[0].Correlation, estimates[1].Correlation)
+
+		total := 0.0
+		for _, estimate := range estimates {
+			total += estimate.Confidence
+		}
+		assert.InDelta(t, 1.0, total, 1e-9)
+	})
+
+	t.Run("durations", func(t *testing.T) {
+		notes := scaleNotes([]string{"A", "B", "C", "D", "E", "F", "G#", "A"}, 0)
+		notes[0].Duration = 4
+		notes[4].Duration = 3
+		notes[7].Duration = 4
+		assert.Equal(t, "A minor", DetectNotes(notes)[0].Key.Name())
+	})
+
+	t.Run("from chords", func(t *testing.T) {
+		chords := []chord.Chord{chord.Get("Ebmaj7"), chord.Get("Cm7"), chord.Get("Fm7"), chord.Get("Bb7"), chord.Get("Eb")}
+		assert.Equal(t, "Eb major", DetectChords(chords)[0].Key.Name())
+
+		chords = []chord.Chord{chord.Get("Am"), chord.Get("Dm"), chord.Get("E7"), chord.Get("Am")}
+		assert.Equal(t, "A minor", DetectChords(chords)[0].Key.Name())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, Detect(Histogram{}))
+		assert.Empty(t, DetectNotes(nil))
+	})
+
+	t.Run("nil notes", func(t *testing.T) {
+		notes := append(scaleNotes([]string{"C", "D", "E", "F", "G", "A", "B", "C"}, 0), nil)
+		assert.Equal(t, "C major", DetectNotes(notes)[0].Key.Name())
+		assert.Equal(t, Histogram{}, NotesHistogram([]*note.Note{nil}))
+	})
+}
+
+func TestDetectTimeline(t *testing.T) {
+	notes := append(
+		scaleNotes([]string{"C", "E", "G", "C", "F", "A", "B", "C"}, 0),
+		scaleNotes([]string{"E", "G#", "B", "E", "A", "C#", "D#", "E"}, 8)...,
+	)
+
+	windows := DetectTimeline(notes, 8, 8)
+	assert.Len(t, windows, 2)
+	assert.Equal(t, 0.0, windows[0].Start)
+	assert.Equal(t, 8.0, windows[0].End)
+	assert.Equal(t, "C major", windows[0].Estimates[0].Key.Name())
+	assert.Equal(t, "E major", windows[1].Estimates[0].Key.Name())
+
+	assert.Len(t, DetectTimeline(notes, 8, 4), 4)
+	assert.Empty(t, DetectTimeline(notes, 0, 4))
+	assert.Len(t, DetectTimeline(append([]*note.Note{nil}, notes...), 8, 8), 2)
+	assert.Empty(t, DetectTimeline([]*note.Note{nil}, 8, 8))
+}
+
+func TestDetectPitches(t *testing.T) {
+	var notes []pitch.Note
+	for _, name := range []string{"Eb", "F", "G", "Ab", "Bb", "C", "D", "Eb"} {
+		notes = append(notes, pitch.MustParseNote(name))
+	}
+	assert.Equal(t, "Eb major", DetectPitches(notes)[0].Key.Name())
+}
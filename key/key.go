@@ -0,0 +1,132 @@
+// Major and minor keys.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/key/index.ts
+package key
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+)
+
+type Mode string
+
+const (
+	Major Mode = "major"
+	Minor Mode = "minor"
+)
+
+// Key is a tonic and a mode. Tonic keeps its spelling ("Eb", "F#").
+type Key struct {
+	Empty bool
+	Tonic string
+	Mode  Mode
+}
+
+var NoKey = Key{
+	Empty: true,
+	Tonic: "",
+	Mode:  "",
+}
+
+var keyRegex = regexp.MustCompile(`^([A-G](?:#+|b+|♯+|♭+)?)\s*(.*)$`)
+
+// Get parses a key name: "C", "C major", "Am", "A minor", "F#m", "Eb-".
+func Get(name string) Key {
+	matches := keyRegex.FindStringSubmatch(strings.TrimSpace(name))
+	if matches == nil {
+		return NoKey
+	}
+
+	var mode Mode
+	switch rest := matches[2]; {
+	case rest == "" || rest == "M":
+		mode = Major
+	case rest == "m" || rest == "-":
+		mode = Minor
+	default:
+		switch strings.ToLower(rest) {
+		case "maj", "major", "ionian":
+			mode = Major
+		case "min", "minor", "aeolian":
+			mode = Minor
+		default:
+			return NoKey
+		}
+	}
+
+	return Key{Empty: false, Tonic: matches[1], Mode: mode}
+}
+
+// Name returns the name of the key, e.g. "C major" or "F# minor".
+func (k Key) Name() string {
+	if k.Empty {
+		return ""
+	}
+	return k.Tonic + " " + string(k.Mode)
+}
+
+// Symbol returns the key written as a chord symbol, e.g. "C" or "F#m".
+func (k Key) Symbol() string {
+	if k.Empty || k.Mode == Major {
+		return k.Tonic
+	}
+	return k.Tonic + "m"
+}
+
+// Fifths returns the number of sharps (positive) or flats (negative) of the key signature.
+func (k Key) Fifths() int {
+	fifths, _ := chord.NoteFifths(k.Tonic)
+	if k.Mode == Minor {
+		fifths -= 3
+	}
+	return fifths
+}
+
+// Positions on the line of fifths of the degrees of the scales, relative to the tonic.
+var (
+	majorDegrees = []int{0, 2, 4, -1, 1, 3, 5}
+	minorDegrees = []int{0, 2, -3, -1, 1, -4, -2}
+)
+
+// Scale returns the notes of the scale of the key (natural minor for minor keys).
+func (k Key) Scale() []string {
+	if k.Empty {
+		return []string{}
+	}
+	tonic, _ := chord.NoteFifths(k.Tonic)
+
+	scale := make([]string, 7)
+	for i, degree := range k.degrees() {
+		scale[i] = chord.FifthsNote(tonic + degree)
+	}
+	return scale
+}
+
+// Degree returns the scale degree (1 to 7) of a note and its alteration relative
+// to the scale of the key: in C major, "Bb" is (7, -1); in A minor, "G#" is (7, 1).
+func (k Key) Degree(note string) (int, int, bool) {
+	tonic, ok := chord.NoteFifths(k.Tonic)
+	fifths, isNote := chord.NoteFifths(note)
+	if k.Empty || !ok || !isNote {
+		return 0, 0, false
+	}
+	return k.DegreeOf(fifths - tonic)
+}
+
+// DegreeOf is like Degree, with the note given as its distance in fifths from the tonic.
+func (k Key) DegreeOf(fifths int) (int, int, bool) {
+	if k.Empty {
+		return 0, 0, false
+	}
+	// A fifth is four steps.
+	step := ((fifths*4)%7 + 7) % 7
+	return step + 1, (fifths - k.degrees()[step]) / 7, true
+}
+
+func (k Key) degrees() []int {
+	if k.Mode == Minor {
+		return minorDegrees
+	}
+	return majorDegrees
+}
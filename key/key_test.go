@@ -0,0 +1,63 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected Key
+	}{
+		{"C", Key{Tonic: "C", Mode: Major}},
+		{"C major", Key{Tonic: "C", Mode: Major}},
+		{"Am", Key{Tonic: "A", Mode: Minor}},
+		{"A minor", Key{Tonic: "A", Mode: Minor}},
+		{"F#m", Key{Tonic: "F#", Mode: Minor}},
+		{"Eb-", Key{Tonic: "Eb", Mode: Minor}},
+		{"BbM", Key{Tonic: "Bb", Mode: Major}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Get(tc.name), "Key: %s", tc.name)
+	}
+
+	assert.Equal(t, NoKey, Get("H"))
+	assert.Equal(t, NoKey, Get("C dorian"))
+}
+
+func TestKey(t *testing.T) {
+	k := Get("F# minor")
+	assert.Equal(t, "F# minor", k.Name())
+	assert.Equal(t, "F#m", k.Symbol())
+	assert.Equal(t, 3, k.Fifths())
+	assert.Equal(t, []string{"F#", "G#", "A", "B", "C#", "D", "E"}, k.Scale())
+
+	k = Get("Eb")
+	assert.Equal(t, -3, k.Fifths())
+	assert.Equal(t, []string{"Eb", "F", "G", "Ab", "Bb", "C", "D"}, k.Scale())
+}
+
+func TestDegree(t *testing.T) {
+	testCases := []struct {
+		key    string
+		note   string
+		degree int
+		alt    int
+	}{
+		{"C", "C", 1, 0},
+		{"C", "G", 5, 0},
+		{"C", "Bb", 7, -1},
+		{"C", "F#", 4, 1},
+		{"Am", "G#", 7, 1},
+		{"Am", "C", 3, 0},
+		{"Eb", "Cb", 6, -1},
+	}
+	for _, tc := range testCases {
+		degree, alt, ok := Get(tc.key).Degree(tc.note)
+		assert.True(t, ok)
+		assert.Equal(t, tc.degree, degree, "%s in %s", tc.note, tc.key)
+		assert.Equal(t, tc.alt, alt, "%s in %s", tc.note, tc.key)
+	}
+}
@@ -4,12 +4,6 @@
 package pcset
 
 import (
-	"fmt"
-	"strconv"
-	"strings"
-
-	"github.com/Golevka2001/go-chord-detector/pitchinterval"
-
 	"github.com/go-music-theory/music-theory/note"
 )
 
@@ -22,7 +16,8 @@ import (
 // or "0" as characters, representing a pitch class or not for the given position
 // in the octave. For example, a "1" at index 0 means 'C', a "1" at index 2 means 'D', and so on.
 //
-// Normalized is the chroma but shifted to the first 1.
+// Normalized is the chroma but shifted to the first 1. It is not the prime form
+// of the set class: see Set.PrimeForm.
 //
 // Intervals are the intervals of the pitch class set *starting from C*.
 type Pcset struct {
@@ -44,13 +39,12 @@ var EmptyPcset = Pcset{
 }
 
 func setNumToChroma(num int) string {
-	binary := fmt.Sprintf("%012b", num)
-	return binary
+	return Set(num).Chroma()
 }
 
 func chromaToNumber(chroma string) int {
-	num, _ := strconv.ParseInt(chroma, 2, 64)
-	return int(num)
+	set, _ := ChromaToSet(chroma)
+	return int(set)
 }
 
 var cache = map[string]Pcset{
@@ -71,16 +65,20 @@ func NotesToPcset(set []*note.Note) Pcset {
 }
 
 // IntervalsToPcset replaces the original `get(src: string[])` method.
-func IntervalsToPcset(set []string) Pcset {
-	chroma := intervalsToChroma(set)
+// Returns the error of the first invalid interval (a *pitchinterval.ParseError).
+func IntervalsToPcset(set []string) (Pcset, error) {
+	chroma, err := intervalsToChroma(set)
+	if err != nil {
+		return EmptyPcset, err
+	}
 
 	if cached, exists := cache[chroma]; exists {
-		return cached
+		return cached, nil
 	}
 
 	pcset := chromaToPcset(chroma)
 	cache[chroma] = pcset
-	return pcset
+	return pcset, nil
 }
 
 var ivls = []string{
@@ -112,29 +110,22 @@ func chromaToIntervals(chroma string) []string {
 //
 // Returns an array with all the modes of the chroma.
 func Modes(set []*note.Note, normalize bool) []string {
-	pcset := NotesToPcset(set)
-	binary := strings.Split(pcset.Chroma, "")
-
 	var modes []string
-	for i := 0; i < len(binary); i++ {
-		rotated := rotate(binary, i)
-		rotatedStr := strings.Join(rotated, "")
-
-		if !normalize || rotatedStr[0] == '1' {
-			modes = append(modes, rotatedStr)
+	for _, rotation := range NotesToSet(set).Rotations() {
+		if !normalize || rotation.Has(0) {
+			modes = append(modes, rotation.Chroma())
 		}
 	}
-	return compact(modes)
+	return modes
 }
 
 func chromaToPcset(chroma string) Pcset {
 	setNum := chromaToNumber(chroma)
 
-	rotations := chromaRotations(chroma)
 	normalizedNum := setNum
-	for _, rotation := range rotations {
-		if rotation[0] == '1' {
-			num := chromaToNumber(rotation)
+	for _, rotation := range Set(setNum).Rotations() {
+		if rotation.Has(0) {
+			num := int(rotation)
 			if num < normalizedNum || normalizedNum < 2048 {
 				normalizedNum = num
 			}
@@ -157,68 +148,13 @@ func chromaToPcset(chroma string) Pcset {
 	}
 }
 
-func chromaRotations(chroma string) []string {
-	binary := strings.Split(chroma, "")
-	var rotations []string
-
-	for i := 0; i < len(binary); i++ {
-		rotated := rotate(binary, i)
-		rotations = append(rotations, strings.Join(rotated, ""))
-	}
-	return rotations
-}
-
 // notesToChroma replaces the original `listToChroma(set: any[])` method.
 func notesToChroma(set []*note.Note) string {
-	if len(set) == 0 {
-		return EmptyPcset.Chroma
-	}
-
-	binary := [12]byte{'0', '0', '0', '0', '0', '0', '0', '0', '0', '0', '0', '0'}
-	for _, n := range set {
-		if n.Class != note.Nil {
-			index := (int(n.Class) - 1) % 12
-			binary[index] = '1'
-		}
-	}
-
-	return string(binary[:])
+	return NotesToSet(set).Chroma()
 }
 
 // intervalsToChroma replaces the original `intervalsToChroma(set: string[])` method.
-func intervalsToChroma(set []string) string {
-	if len(set) == 0 {
-		return EmptyPcset.Chroma
-	}
-
-	binary := [12]byte{'0', '0', '0', '0', '0', '0', '0', '0', '0', '0', '0', '0'}
-	for _, interval := range set {
-		index := pitchinterval.Parse(interval).Chroma
-		if index != -1 {
-			binary[index] = '1'
-		}
-	}
-	return string(binary[:])
-}
-
-func rotate(slice []string, n int) []string {
-	if len(slice) == 0 {
-		return slice
-	}
-	length := len(slice)
-	n = ((n % length) + length) % length
-	result := make([]string, length)
-	copy(result, slice[n:])
-	copy(result[length-n:], slice[:n])
-	return result
-}
-
-func compact(arr []string) []string {
-	result := make([]string, 0)
-	for _, item := range arr {
-		if item != "" {
-			result = append(result, item)
-		}
-	}
-	return result
+func intervalsToChroma(set []string) (string, error) {
+	s, err := IntervalsToSet(set)
+	return s.Chroma(), err
 }
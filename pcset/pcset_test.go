@@ -0,0 +1,41 @@
+package pcset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalsToPcset(t *testing.T) {
+	pcset, err := IntervalsToPcset([]string{"1P", "3M", "5P", "7M"})
+	assert.NoError(t, err)
+	assert.Equal(t, "100010010001", pcset.Chroma)
+	assert.Equal(t, []string{"1P", "3M", "5P", "7M"}, pcset.Intervals)
+
+	pcset, err = IntervalsToPcset([]string{"1P", "3M", "0M"})
+	assert.Equal(t, EmptyPcset, pcset)
+	assert.ErrorIs(t, err, pitchinterval.ErrZero)
+
+	_, err = IntervalsToPcset([]string{"1P", "blah"})
+	assert.ErrorIs(t, err, pitchinterval.ErrSyntax)
+}
+
+func FuzzIntervalsToPcset(f *testing.F) {
+	for _, seed := range []string{"1P 3M 5P", "1P 3m 5d 7d", "0M", "2P 5M", "1P  3M", ""} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		pcset, err := IntervalsToPcset(strings.Split(input, " "))
+		if err != nil {
+			if pcset.SetNum != 0 {
+				t.Errorf("%q: error with a non-empty pcset", input)
+			}
+			return
+		}
+		if pcset.Set().Pcset().Chroma != pcset.Chroma {
+			t.Errorf("%q: %s doesn't convert back", input, pcset.Chroma)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package pcset
+
+import (
+	"math/bits"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Set is a pitch class set stored in 12 bits.
+//
+// The bits follow the chroma: the highest of the 12 bits (2048) is C and the
+// lowest (1) is B, so a Set is the SetNum of the same Pcset and the chroma is the
+// set written in binary. Pitch classes are numbered from 0 (C) to 11 (B).
+type Set uint16
+
+const (
+	EmptySet     Set = 0
+	ChromaticSet Set = 0xFFF
+)
+
+// SetOf returns the set of the given pitch classes (0 is C). Pitch classes out
+// of 0-11 are reduced to one octave.
+func SetOf(pitchClasses ...int) Set {
+	var s Set
+	for _, pc := range pitchClasses {
+		s |= bit(pc)
+	}
+	return s
+}
+
+// NotesToSet returns the set of the pitch classes of the notes.
+func NotesToSet(notes []*note.Note) Set {
+	var s Set
+	for _, n := range notes {
+		if n != nil && n.Class != note.Nil {
+			s |= bit(int(n.Class) - 1)
+		}
+	}
+	return s
+}
+
+// IntervalsToSet returns the set of the intervals, starting from C.
+// Returns the error of the first invalid interval (a *pitchinterval.ParseError).
+func IntervalsToSet(intervals []string) (Set, error) {
+	var s Set
+	for _, name := range intervals {
+		interval, err := pitchinterval.ParseInterval(name)
+		if err != nil {
+			return EmptySet, err
+		}
+		s |= bit(interval.Chroma)
+	}
+	return s, nil
+}
+
+// ChromaToSet returns the set of a chroma ("101011010101").
+// Returns false if the chroma is not 12 characters of "0" and "1".
+func ChromaToSet(chroma string) (Set, bool) {
+	if len(chroma) != 12 {
+		return EmptySet, false
+	}
+	var s Set
+	for i := 0; i < 12; i++ {
+		switch chroma[i] {
+		case '1':
+			s |= bit(i)
+		case '0':
+		default:
+			return EmptySet, false
+		}
+	}
+	return s, true
+}
+
+// Set returns the bitset of a Pcset.
+func (p Pcset) Set() Set {
+	return Set(p.SetNum) & ChromaticSet
+}
+
+// Pcset returns the Pcset with the same pitch classes.
+func (s Set) Pcset() Pcset {
+	if s == EmptySet {
+		return EmptyPcset
+	}
+	chroma := s.Chroma()
+	if cached, exists := cache[chroma]; exists {
+		return cached
+	}
+	pcset := chromaToPcset(chroma)
+	cache[chroma] = pcset
+	return pcset
+}
+
+// Chroma returns the set as a 12-char string of "0" and "1", starting from C.
+func (s Set) Chroma() string {
+	binary := make([]byte, 12)
+	for i := 0; i < 12; i++ {
+		if s.Has(i) {
+			binary[i] = '1'
+		} else {
+			binary[i] = '0'
+		}
+	}
+	return string(binary)
+}
+
+// String returns the chroma of the set.
+func (s Set) String() string {
+	return s.Chroma()
+}
+
+// PitchClasses returns the pitch classes of the set in ascending order, 0 is C.
+func (s Set) PitchClasses() []int {
+	result := make([]int, 0, s.Len())
+	for pc := 0; pc < 12; pc++ {
+		if s.Has(pc) {
+			result = append(result, pc)
+		}
+	}
+	return result
+}
+
+// Notes returns a note without octave for each pitch class, starting from C.
+func (s Set) Notes() []*note.Note {
+	notes := make([]*note.Note, 0, s.Len())
+	for _, pc := range s.PitchClasses() {
+		notes = append(notes, &note.Note{Class: note.Class(pc + 1)})
+	}
+	return notes
+}
+
+// Len returns the number of pitch classes.
+func (s Set) Len() int {
+	return bits.OnesCount16(uint16(s & ChromaticSet))
+}
+
+// Has returns true if the set has the pitch class (0 is C).
+func (s Set) Has(pitchClass int) bool {
+	return s&bit(pitchClass) != 0
+}
+
+// Contains returns true if the set has the pitch class of the note.
+func (s Set) Contains(n *note.Note) bool {
+	if n == nil || n.Class == note.Nil {
+		return false
+	}
+	return s.Has(int(n.Class) - 1)
+}
+
+func (s Set) Union(other Set) Set {
+	return s | other
+}
+
+func (s Set) Intersection(other Set) Set {
+	return s & other
+}
+
+// Difference returns the pitch classes of the set that are not in the other one.
+func (s Set) Difference(other Set) Set {
+	return s &^ other
+}
+
+// Complement returns the pitch classes that are not in the set.
+func (s Set) Complement() Set {
+	return ^s & ChromaticSet
+}
+
+// IsSubsetOf returns true if every pitch class of the set is in the other one.
+func (s Set) IsSubsetOf(other Set) bool {
+	return s&^other == 0
+}
+
+// IsSupersetOf returns true if every pitch class of the other set is in the set.
+func (s Set) IsSupersetOf(other Set) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Tn transposes the set up by n semitones.
+func (s Set) Tn(n int) Set {
+	n = mod12(n)
+	s &= ChromaticSet
+	// Higher pitch classes are lower bits.
+	return (s>>n | s<<(12-n)) & ChromaticSet
+}
+
+// TnI inverts the set around C, then transposes it up by n semitones:
+// every pitch class p becomes n - p.
+func (s Set) TnI(n int) Set {
+	var result Set
+	for _, pc := range s.PitchClasses() {
+		result |= bit(n - pc)
+	}
+	return result
+}
+
+// Rotate moves the chroma n pitch classes to the left, so that it starts at the
+// pitch class n: the rotations of a scale starting with "1" are its modes.
+func (s Set) Rotate(n int) Set {
+	return s.Tn(-n)
+}
+
+// Rotations returns the 12 rotations of the set, starting from the set itself.
+func (s Set) Rotations() []Set {
+	rotations := make([]Set, 12)
+	for i := range rotations {
+		rotations[i] = s.Rotate(i)
+	}
+	return rotations
+}
+
+func bit(pitchClass int) Set {
+	return 1 << (11 - mod12(pitchClass))
+}
+
+func mod12(n int) int {
+	return ((n % 12) + 12) % 12
+}
@@ -0,0 +1,192 @@
+package pcset
+
+import (
+	"testing"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	cMajorTriad = SetOf(0, 4, 7)
+	cMajorScale = SetOf(0, 2, 4, 5, 7, 9, 11)
+)
+
+func TestSetConversions(t *testing.T) {
+	assert.Equal(t, "100010010000", cMajorTriad.Chroma())
+	assert.Equal(t, Set(2192), cMajorTriad, "A set is the SetNum of its pcset")
+	assert.Equal(t, 2192, cMajorTriad.Pcset().SetNum)
+	pcset, err := IntervalsToPcset([]string{"1P", "3M", "5P"})
+	assert.NoError(t, err)
+	assert.Equal(t, cMajorTriad, pcset.Set())
+	set, err := IntervalsToSet([]string{"1P", "3M", "5P"})
+	assert.NoError(t, err)
+	assert.Equal(t, cMajorTriad, set)
+	assert.Equal(t, EmptyPcset, EmptySet.Pcset())
+
+	set, ok := ChromaToSet("101011010101")
+	assert.True(t, ok)
+	assert.Equal(t, cMajorScale, set)
+	_, ok = ChromaToSet("1010")
+	assert.False(t, ok)
+	_, ok = ChromaToSet("10101101010x")
+	assert.False(t, ok)
+
+	notes := []*note.Note{{Class: note.E}, {Class: note.C}, {Class: note.G}, {Class: note.C, Octave: 5}}
+	assert.Equal(t, cMajorTriad, NotesToSet(notes))
+	assert.Equal(t, []int{0, 4, 7}, cMajorTriad.PitchClasses())
+	assert.Equal(t, []*note.Note{{Class: note.C}, {Class: note.E}, {Class: note.G}}, cMajorTriad.Notes())
+}
+
+func TestSetOperations(t *testing.T) {
+	aMinorTriad := SetOf(9, 0, 4)
+	assert.Equal(t, SetOf(0, 4, 7, 9), cMajorTriad.Union(aMinorTriad))
+	assert.Equal(t, SetOf(0, 4), cMajorTriad.Intersection(aMinorTriad))
+	assert.Equal(t, SetOf(7), cMajorTriad.Difference(aMinorTriad))
+	assert.Equal(t, SetOf(1, 3, 6, 8, 10), cMajorScale.Complement())
+	assert.Equal(t, ChromaticSet, EmptySet.Complement())
+
+	assert.True(t, cMajorTriad.IsSubsetOf(cMajorScale))
+	assert.True(t, cMajorScale.IsSupersetOf(cMajorTriad))
+	assert.False(t, cMajorScale.IsSubsetOf(cMajorTriad))
+	assert.True(t, EmptySet.IsSubsetOf(cMajorTriad))
+
+	assert.Equal(t, 3, cMajorTriad.Len())
+	assert.True(t, cMajorTriad.Contains(&note.Note{Class: note.G, Octave: 3}))
+	assert.False(t, cMajorTriad.Contains(&note.Note{Class: note.A}))
+	assert.False(t, cMajorTriad.Contains(&note.Note{Class: note.Nil}))
+}
+
+func TestSetTransformations(t *testing.T) {
+	assert.Equal(t, SetOf(2, 6, 9), cMajorTriad.Tn(2))
+	assert.Equal(t, SetOf(11, 3, 6), cMajorTriad.Tn(-1))
+	assert.Equal(t, cMajorTriad, cMajorTriad.Tn(12))
+	// C major inverted around C is F minor.
+	assert.Equal(t, SetOf(0, 8, 5), cMajorTriad.TnI(0))
+	assert.Equal(t, SetOf(7, 3, 0), cMajorTriad.TnI(7))
+
+	// Rotating C major to D gives the dorian mode.
+	assert.Equal(t, "101101010110", cMajorScale.Rotate(2).Chroma())
+	rotations := cMajorScale.Rotations()
+	assert.Len(t, rotations, 12)
+	assert.Equal(t, cMajorScale, rotations[0])
+	for _, rotation := range rotations {
+		assert.Equal(t, 7, rotation.Len())
+	}
+}
+
+func TestModes(t *testing.T) {
+	notes := []*note.Note{{Class: note.C}, {Class: note.E}, {Class: note.G}}
+	assert.Equal(t, []string{"100010010000", "100100001000", "100001000100"}, Modes(notes, true))
+	assert.Len(t, Modes(notes, false), 12)
+}
+
+func TestNormalForm(t *testing.T) {
+	assert.Equal(t, []int{11, 0, 4, 7}, SetOf(0, 4, 7, 11).NormalForm())
+	assert.Equal(t, []int{11, 2, 5, 7}, SetOf(2, 5, 7, 11).NormalForm())
+	assert.Equal(t, []int{}, EmptySet.NormalForm())
+
+	// 5-20: the two algorithms break the tie differently.
+	set := SetOf(0, 1, 5, 6, 8)
+	assert.Equal(t, []int{0, 1, 5, 6, 8}, set.NormalForm())
+	assert.Equal(t, []int{5, 6, 8, 0, 1}, set.NormalFormForte())
+}
+
+func TestPrimeForm(t *testing.T) {
+	assert.Equal(t, SetOf(0, 3, 7), SetOf(0, 4, 7).PrimeForm(), "Major and minor triads are 037")
+	assert.Equal(t, SetOf(0, 3, 7), SetOf(9, 0, 4).PrimeForm())
+	assert.Equal(t, SetOf(0, 2, 5, 8), SetOf(7, 11, 2, 5).PrimeForm(), "Dominant seventh")
+	assert.Equal(t, SetOf(0, 1, 5, 6, 8), SetOf(0, 1, 3, 7, 8).PrimeForm())
+	assert.Equal(t, SetOf(0, 1, 3, 7, 8), SetOf(0, 1, 5, 6, 8).PrimeFormForte())
+	assert.Equal(t, EmptySet, EmptySet.PrimeForm())
+}
+
+func TestIntervalVector(t *testing.T) {
+	assert.Equal(t, [6]int{0, 0, 1, 1, 1, 0}, SetOf(0, 4, 7).IntervalVector())
+	assert.Equal(t, [6]int{2, 5, 4, 3, 6, 1}, cMajorScale.IntervalVector())
+	assert.Equal(t, [6]int{1, 1, 1, 1, 1, 1}, SetOf(0, 1, 4, 6).IntervalVector())
+}
+
+func TestEquivalence(t *testing.T) {
+	major, minor := SetOf(0, 4, 7), SetOf(9, 0, 4)
+	assert.True(t, major.IsTnEquivalent(major.Tn(5)))
+	assert.False(t, major.IsTnEquivalent(minor))
+	assert.True(t, major.IsTnIEquivalent(minor))
+	assert.False(t, major.IsTnIEquivalent(SetOf(0, 4, 8)))
+
+	// 4-Z15 and 4-Z29 have the same interval vector.
+	assert.True(t, SetOf(0, 1, 4, 6).IsZRelated(SetOf(0, 1, 3, 7)))
+	assert.False(t, major.IsZRelated(minor))
+}
+
+func TestSymmetry(t *testing.T) {
+	wholeTone := SetOf(0, 2, 4, 6, 8, 10)
+	diminished := SetOf(0, 3, 6, 9)
+	cMajor7 := SetOf(0, 4, 7, 11)
+
+	assert.Equal(t, []int{0}, cMajorScale.TnInvariance())
+	assert.Equal(t, []int{4}, cMajorScale.TnIInvariance(), "C major is symmetrical around D")
+	assert.Equal(t, []int{0, 3, 6, 9}, diminished.TnInvariance())
+	assert.Equal(t, []int{0, 3, 6, 9}, diminished.TnIInvariance())
+	assert.Equal(t, []int{11}, cMajor7.TnIInvariance())
+
+	assert.Equal(t, 1, SetOf(0, 1, 4, 6).DegreeOfSymmetry())
+	assert.Equal(t, 2, cMajor7.DegreeOfSymmetry())
+	assert.Equal(t, 8, diminished.DegreeOfSymmetry())
+	assert.Equal(t, 24, ChromaticSet.DegreeOfSymmetry())
+
+	assert.Equal(t, 2, wholeTone.Transpositions())
+	assert.Equal(t, 12, cMajorScale.Transpositions())
+	assert.True(t, wholeTone.IsLimitedTransposition())
+	assert.True(t, SetOf(0, 1, 3, 4, 6, 7, 9, 10).IsLimitedTransposition(), "Octatonic")
+	assert.True(t, SetOf(0, 2, 3, 4, 6, 7, 8, 10, 11).IsLimitedTransposition(), "Messiaen's third mode")
+	assert.False(t, cMajorScale.IsLimitedTransposition())
+	assert.False(t, ChromaticSet.IsLimitedTransposition())
+	assert.False(t, EmptySet.IsLimitedTransposition())
+}
+
+func TestEvenness(t *testing.T) {
+	pentatonic := SetOf(0, 2, 4, 7, 9)
+	harmonicMinor := SetOf(0, 2, 3, 5, 7, 8, 11)
+
+	assert.Equal(t, []int{1, 2}, cMajorScale.Spectrum(1))
+	assert.Equal(t, []int{5, 6}, cMajorScale.Spectrum(3))
+	assert.Equal(t, []int{12}, cMajorScale.Spectrum(7))
+	assert.Equal(t, []int{1, 2, 3}, harmonicMinor.Spectrum(1))
+
+	for _, set := range []Set{cMajorScale, pentatonic, SetOf(0, 2, 4, 6, 8, 10), SetOf(0, 3, 6, 9), SetOf(0, 6), SetOf(0), EmptySet} {
+		assert.True(t, set.IsMaximallyEven(), set.Chroma())
+	}
+	assert.False(t, harmonicMinor.IsMaximallyEven())
+	assert.False(t, SetOf(0, 4, 7).IsMaximallyEven())
+	assert.True(t, SetOf(0, 4, 8).IsMaximallyEven())
+
+	assert.True(t, cMajorScale.HasMyhillProperty())
+	assert.True(t, pentatonic.HasMyhillProperty())
+	assert.False(t, harmonicMinor.HasMyhillProperty())
+	assert.False(t, SetOf(0, 2, 4, 6, 8, 10).HasMyhillProperty())
+
+	assert.True(t, cMajorScale.IsDeep())
+	assert.False(t, SetOf(0, 1, 3, 7).IsDeep())
+	assert.False(t, pentatonic.IsDeep(), "032140 repeats 0")
+}
+
+func TestEquivalenceClasses(t *testing.T) {
+	counts := func(inversion bool) []int {
+		result := make([]int, 13)
+		for cardinality := range result {
+			result[cardinality] = len(EquivalenceClasses(cardinality, inversion))
+		}
+		return result
+	}
+	assert.Equal(t, []int{1, 1, 6, 19, 43, 66, 80, 66, 43, 19, 6, 1, 1}, counts(false))
+	assert.Equal(t, []int{1, 1, 6, 12, 29, 38, 50, 38, 29, 12, 6, 1, 1}, counts(true))
+
+	triads := EquivalenceClasses(3, false)
+	assert.Equal(t, []Set{SetOf(9, 10, 11)}, triads[0][:1], "Sets are ordered by SetNum")
+	total := 0
+	for _, class := range triads {
+		total += len(class)
+	}
+	assert.Equal(t, 220, total)
+}
@@ -0,0 +1,137 @@
+package pcset
+
+// The normal form of a set is the ordering of its pitch classes that spans the
+// smallest interval. When several orderings span the same interval, Rahn's
+// algorithm (used by most textbooks and by NormalForm) keeps the one most packed
+// to the right: the smallest interval from the first to the second-to-last pitch
+// class, then to the third-to-last... Forte's algorithm keeps the one most packed
+// to the left: the smallest interval from the first to the second pitch class,
+// then to the third... The two only disagree for a few sets, like 5-20 (01378 for
+// Forte, 01568 for Rahn).
+
+// NormalForm returns the pitch classes of the set in normal form, with Rahn's
+// algorithm: [11 0 4 7] for B C E G.
+func (s Set) NormalForm() []int {
+	return normalForm(s, rahnOrder)
+}
+
+// NormalFormForte is like NormalForm, with Forte's algorithm.
+func (s Set) NormalFormForte() []int {
+	return normalForm(s, forteOrder)
+}
+
+// PrimeForm returns the most packed of the normal forms of the set and of its
+// inversion, transposed to start on C, with Rahn's algorithm: 037 for major and
+// minor triads.
+func (s Set) PrimeForm() Set {
+	return primeForm(s, rahnOrder)
+}
+
+// PrimeFormForte is like PrimeForm, with Forte's algorithm.
+func (s Set) PrimeFormForte() Set {
+	return primeForm(s, forteOrder)
+}
+
+// IntervalVector counts the pairs of pitch classes of the set by interval class,
+// from the minor second (or major seventh) to the tritone.
+func (s Set) IntervalVector() [6]int {
+	var vector [6]int
+	pcs := s.PitchClasses()
+	for i := 0; i < len(pcs); i++ {
+		for j := i + 1; j < len(pcs); j++ {
+			interval := pcs[j] - pcs[i]
+			if interval > 6 {
+				interval = 12 - interval
+			}
+			vector[interval-1]++
+		}
+	}
+	return vector
+}
+
+// IsTnEquivalent returns true if the set is a transposition of the other one.
+func (s Set) IsTnEquivalent(other Set) bool {
+	for n := 0; n < 12; n++ {
+		if s.Tn(n) == other {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTnIEquivalent returns true if the set is a transposition or an inversion of
+// the other one, that is if both belong to the same set class.
+func (s Set) IsTnIEquivalent(other Set) bool {
+	return s.Len() == other.Len() && s.PrimeForm() == other.PrimeForm()
+}
+
+// IsZRelated returns true if the sets have the same interval vector without
+// belonging to the same set class, like 4-Z15 (0146) and 4-Z29 (0137).
+func (s Set) IsZRelated(other Set) bool {
+	return s.IntervalVector() == other.IntervalVector() && !s.IsTnIEquivalent(other)
+}
+
+// order returns the intervals above the first pitch class of an ordering, in the
+// order they are compared.
+type order func(intervals []int) []int
+
+func rahnOrder(intervals []int) []int {
+	result := make([]int, 0, len(intervals))
+	for i := len(intervals) - 1; i > 0; i-- {
+		result = append(result, intervals[i])
+	}
+	return result
+}
+
+func forteOrder(intervals []int) []int {
+	if len(intervals) < 2 {
+		return nil
+	}
+	return append([]int{intervals[len(intervals)-1]}, intervals[1:len(intervals)-1]...)
+}
+
+func normalForm(s Set, by order) []int {
+	pcs := s.PitchClasses()
+	var best []int
+	var bestKey []int
+	for i := range pcs {
+		rotation := append(append([]int{}, pcs[i:]...), pcs[:i]...)
+		key := by(intervalsAboveFirst(rotation))
+		if best == nil || less(key, bestKey) {
+			best, bestKey = rotation, key
+		}
+	}
+	if best == nil {
+		return []int{}
+	}
+	return best
+}
+
+func primeForm(s Set, by order) Set {
+	if s == EmptySet {
+		return EmptySet
+	}
+	original := normalForm(s, by)
+	inverted := normalForm(s.TnI(0), by)
+	if less(by(intervalsAboveFirst(inverted)), by(intervalsAboveFirst(original))) {
+		return s.TnI(0).Tn(-inverted[0])
+	}
+	return s.Tn(-original[0])
+}
+
+func intervalsAboveFirst(pcs []int) []int {
+	intervals := make([]int, len(pcs))
+	for i, pc := range pcs {
+		intervals[i] = mod12(pc - pcs[0])
+	}
+	return intervals
+}
+
+func less(a []int, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package pcset
+
+import "sort"
+
+// TnInvariance returns the transpositions (0 to 11) that map the set onto itself.
+// Every set is invariant under T0.
+func (s Set) TnInvariance() []int {
+	result := make([]int, 0)
+	for n := 0; n < 12; n++ {
+		if s.Tn(n) == s {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// TnIInvariance returns the inversions TnI (0 to 11) that map the set onto itself.
+func (s Set) TnIInvariance() []int {
+	result := make([]int, 0)
+	for n := 0; n < 12; n++ {
+		if s.TnI(n) == s {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// DegreeOfSymmetry returns the number of operations, transpositions and
+// inversions, that map the set onto itself: 1 for an asymmetrical set, 2 for a
+// major seventh chord, 8 for a diminished seventh chord.
+func (s Set) DegreeOfSymmetry() int {
+	return len(s.TnInvariance()) + len(s.TnIInvariance())
+}
+
+// Transpositions returns the number of distinct transpositions of the set:
+// 12 for most sets, 2 for the whole tone scale.
+func (s Set) Transpositions() int {
+	return 12 / len(s.TnInvariance())
+}
+
+// IsLimitedTransposition returns true if the set has fewer than 12 distinct
+// transpositions, like Messiaen's modes of limited transposition. The empty and
+// the chromatic sets are excluded.
+func (s Set) IsLimitedTransposition() bool {
+	return s != EmptySet && s != ChromaticSet && s.Transpositions() < 12
+}
+
+// Spectrum returns the sizes in semitones, in ascending order, of a generic
+// interval of the set: the distances between each pitch class and the one
+// generic steps above it. In the diatonic scale, the generic second (1) is 1 or
+// 2 semitones and the generic fourth (3) is 5 or 6.
+func (s Set) Spectrum(generic int) []int {
+	pcs := s.PitchClasses()
+	size := len(pcs)
+	if size == 0 {
+		return []int{}
+	}
+
+	seen := make(map[int]bool)
+	result := make([]int, 0)
+	for i, pc := range pcs {
+		j := i + generic
+		semitones := pcs[((j%size)+size)%size] - pc + 12*floorDiv(j, size)
+		if !seen[semitones] {
+			seen[semitones] = true
+			result = append(result, semitones)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// IsMaximallyEven returns true if the pitch classes are spread as evenly as
+// possible in the octave: every generic interval comes in one size, or in two
+// sizes a semitone apart. The diatonic and pentatonic scales, the whole tone
+// scale and the diminished seventh chord are maximally even.
+func (s Set) IsMaximallyEven() bool {
+	for generic := 1; generic < s.Len(); generic++ {
+		spectrum := s.Spectrum(generic)
+		if spectrum[len(spectrum)-1]-spectrum[0] > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// HasMyhillProperty returns true if every generic interval comes in exactly two
+// sizes, like the diatonic and pentatonic scales.
+func (s Set) HasMyhillProperty() bool {
+	if s.Len() < 2 {
+		return false
+	}
+	for generic := 1; generic < s.Len(); generic++ {
+		if len(s.Spectrum(generic)) != 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDeep returns true if every interval class occurs a different number of
+// times, like in the diatonic scale (interval vector 254361).
+func (s Set) IsDeep() bool {
+	seen := make(map[int]bool)
+	for _, count := range s.IntervalVector() {
+		if seen[count] {
+			return false
+		}
+		seen[count] = true
+	}
+	return true
+}
+
+// EquivalenceClasses returns the sets of a cardinality grouped by transposition,
+// or by transposition and inversion (the set classes). Each class lists its sets
+// in ascending order, and the classes are ordered by their first set.
+func EquivalenceClasses(cardinality int, inversion bool) [][]Set {
+	result := make([][]Set, 0)
+	index := make(map[Set]int)
+	for s := EmptySet; s <= ChromaticSet; s++ {
+		if s.Len() != cardinality {
+			continue
+		}
+		representative := s.PrimeForm()
+		if !inversion && s != EmptySet {
+			representative = s.Tn(-s.NormalForm()[0])
+		}
+		if i, exists := index[representative]; exists {
+			result[i] = append(result[i], s)
+			continue
+		}
+		index[representative] = len(result)
+		result = append(result, []Set{s})
+	}
+	return result
+}
+
+func floorDiv(a int, b int) int {
+	if a < 0 && a%b != 0 {
+		return a/b - 1
+	}
+	return a / b
+}
@@ -0,0 +1,230 @@
+package pitch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Note is a spelled note: a letter from 'A' to 'G', any number of sharps (Alt
+// above zero) or flats (Alt below zero) and an optional octave. Unlike
+// note.Class, Fb and E, or C## and D, are different notes.
+//
+// Octaves are numbered from C, like in scientific pitch notation: B#3 sounds
+// like C4 and Cb4 like B3. Notes without an octave (HasOct is false) are pitch
+// classes.
+type Note struct {
+	Letter byte
+	Alt    int
+	Oct    int
+	HasOct bool
+}
+
+var ErrNote = errors.New("invalid note name")
+
+// Notes are written with a letter, accidentals and an optional octave. Sharps
+// are "#", "♯" or "x", "𝄪" for two; flats are "b" or "♭", "𝄫" for two.
+var noteRegex = regexp.MustCompile(`^([A-G])((?:#|x|♯|𝄪|b|♭|𝄫)*)(-?\d+)?$`)
+
+const letters = "CDEFGAB"
+
+// ParseNote reads a note name: "C", "F#4", "Bbb", "Ebb-1", "G♯3", "D𝄫".
+// Returns an error wrapping ErrNote for invalid names, lower case letters and
+// notes that mix sharps and flats.
+func ParseNote(name string) (Note, error) {
+	matches := noteRegex.FindStringSubmatch(strings.TrimSpace(name))
+	if matches == nil {
+		return Note{}, fmt.Errorf("pitch: %q: %w", name, ErrNote)
+	}
+
+	n := Note{Letter: matches[1][0]}
+	sharps, flats := 0, 0
+	for _, accidental := range matches[2] {
+		switch accidental {
+		case '#', '♯':
+			sharps++
+		case 'x', '𝄪':
+			sharps += 2
+		case 'b', '♭':
+			flats++
+		case '𝄫':
+			flats += 2
+		}
+	}
+	if sharps > 0 && flats > 0 {
+		return Note{}, fmt.Errorf("pitch: %q: %w", name, ErrNote)
+	}
+	n.Alt = sharps - flats
+
+	if matches[3] != "" {
+		oct, err := strconv.Atoi(matches[3])
+		if err != nil {
+			return Note{}, fmt.Errorf("pitch: %q: %w", name, ErrNote)
+		}
+		n.Oct, n.HasOct = oct, true
+	}
+	return n, nil
+}
+
+// MustParseNote is like ParseNote but panics if the name is invalid.
+func MustParseNote(name string) Note {
+	n, err := ParseNote(name)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// NoteOf returns the note of a pitch without direction.
+func NoteOf(p Pitch) Note {
+	return Note{Letter: letters[mod(p.Step, 7)], Alt: p.Alt, Oct: p.Oct, HasOct: p.HasOct}
+}
+
+// Pitch returns the note as a pitch: Step is the index of the letter from C.
+func (n Note) Pitch() Pitch {
+	return Pitch{Step: strings.IndexByte(letters, n.Letter), Alt: n.Alt, Oct: n.Oct, HasOct: n.HasOct}
+}
+
+// Coordinates returns the position of the note on the line of fifths.
+func (n Note) Coordinates() Coordinates {
+	return Encode(n.Pitch())
+}
+
+// PitchClass returns the note without its octave.
+func (n Note) PitchClass() Note {
+	n.Oct, n.HasOct = 0, false
+	return n
+}
+
+// Chroma returns the pitch class of the note, 0 is C: Cb is 11, B# is 0.
+func (n Note) Chroma() int {
+	return n.Coordinates().Chroma()
+}
+
+// Accidentals returns the accidentals of the note in ASCII: "#", "bb".
+func (n Note) Accidentals() string {
+	if n.Alt > 0 {
+		return strings.Repeat("#", n.Alt)
+	}
+	return strings.Repeat("b", -n.Alt)
+}
+
+// String returns the name of the note in ASCII: "C#4", "Ebb", "F##".
+func (n Note) String() string {
+	if n.Letter == 0 {
+		return ""
+	}
+	name := string(n.Letter) + n.Accidentals()
+	if n.HasOct {
+		name += strconv.Itoa(n.Oct)
+	}
+	return name
+}
+
+// Unicode returns the name of the note with music symbols: "C♯4", "E𝄫",
+// "F𝄪♯".
+func (n Note) Unicode() string {
+	if n.Letter == 0 {
+		return ""
+	}
+	double, single := "𝄪", "♯"
+	alt := n.Alt
+	if alt < 0 {
+		double, single, alt = "𝄫", "♭", -alt
+	}
+	name := string(n.Letter) + strings.Repeat(double, alt/2) + strings.Repeat(single, alt%2)
+	if n.HasOct {
+		name += strconv.Itoa(n.Oct)
+	}
+	return name
+}
+
+// MIDI returns the MIDI number of the note: C4 is 60, A4 is 69, B#3 is 60.
+// Returns false if the note has no octave.
+func (n Note) MIDI() (int, bool) {
+	if !n.HasOct {
+		return 0, false
+	}
+	// Semitones count from C0, MIDI numbers from C-1.
+	return n.Coordinates().Semitones() + 12, true
+}
+
+// FromMIDI returns the note of a MIDI number, spelled with sharps: 61 is C#4.
+func FromMIDI(midi int) Note {
+	n := FromClass(note.Class(mod(midi, 12) + 1))
+	n.Oct, n.HasOct = floorDiv(midi, 12)-1, true
+	return n
+}
+
+// Frequency returns the frequency in Hz of the note in equal temperament, with
+// A4 at 440 Hz. Returns false if the note has no octave.
+func (n Note) Frequency() (float64, bool) {
+	return n.FrequencyAt(440)
+}
+
+// FrequencyAt is like Frequency with another tuning of A4.
+func (n Note) FrequencyAt(a4 float64) (float64, bool) {
+	midi, ok := n.MIDI()
+	if !ok {
+		return 0, false
+	}
+	return a4 * math.Pow(2, float64(midi-69)/12), true
+}
+
+// FromFrequency returns the note closest to a frequency in Hz, with A4 at
+// 440 Hz, spelled with sharps. Returns false if the frequency is not positive.
+func FromFrequency(frequency float64) (Note, bool) {
+	if frequency <= 0 || math.IsInf(frequency, 0) || math.IsNaN(frequency) {
+		return Note{}, false
+	}
+	midi := 69 + 12*math.Log2(frequency/440)
+	return FromMIDI(int(math.Round(midi))), true
+}
+
+// FromClass returns the note without octave of a pitch class, spelled like
+// note.Class does with sharps.
+func FromClass(class note.Class) Note {
+	if class == note.Nil {
+		return Note{}
+	}
+	n, _ := ParseNote(class.String(note.Sharp))
+	return n
+}
+
+// FromNote returns the note of a note.Note, spelled with sharps, with its octave.
+func FromNote(n *note.Note) Note {
+	if n == nil || n.Class == note.Nil {
+		return Note{}
+	}
+	result := FromClass(n.Class)
+	result.Oct, result.HasOct = int(n.Octave), true
+	return result
+}
+
+// ToNote returns the note.Note with the same pitch class and height: the
+// spelling is lost, Cb4 becomes B3. Notes without octave are in octave 0.
+func (n Note) ToNote() *note.Note {
+	if n.Letter == 0 {
+		return &note.Note{}
+	}
+	coord := n.Coordinates()
+	result := &note.Note{Class: note.Class(coord.Chroma() + 1)}
+	if n.HasOct {
+		result.Octave = note.Octave(floorDiv(coord.Semitones(), 12))
+	}
+	return result
+}
+
+// ToNotes converts notes to note.Note, see ToNote.
+func ToNotes(notes []Note) []*note.Note {
+	result := make([]*note.Note, len(notes))
+	for i, n := range notes {
+		result[i] = n.ToNote()
+	}
+	return result
+}
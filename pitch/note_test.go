@@ -0,0 +1,116 @@
+package pitch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNote(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected Note
+		ascii    string
+		unicode  string
+	}{
+		{"C", Note{Letter: 'C'}, "C", "C"},
+		{"F#4", Note{Letter: 'F', Alt: 1, Oct: 4, HasOct: true}, "F#4", "F♯4"},
+		{"Bbb", Note{Letter: 'B', Alt: -2}, "Bbb", "B𝄫"},
+		{"Ebb-1", Note{Letter: 'E', Alt: -2, Oct: -1, HasOct: true}, "Ebb-1", "E𝄫-1"},
+		{"Cx", Note{Letter: 'C', Alt: 2}, "C##", "C𝄪"},
+		{"G♯3", Note{Letter: 'G', Alt: 1, Oct: 3, HasOct: true}, "G#3", "G♯3"},
+		{"D𝄫", Note{Letter: 'D', Alt: -2}, "Dbb", "D𝄫"},
+		{"F𝄪#", Note{Letter: 'F', Alt: 3}, "F###", "F𝄪♯"},
+		{"A♭♭♭2", Note{Letter: 'A', Alt: -3, Oct: 2, HasOct: true}, "Abbb2", "A𝄫♭2"},
+	}
+	for _, tc := range testCases {
+		n, err := ParseNote(tc.name)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, n, tc.name)
+		assert.Equal(t, tc.ascii, n.String(), tc.name)
+		assert.Equal(t, tc.unicode, n.Unicode(), tc.name)
+	}
+
+	for _, name := range []string{"", "H", "c4", "C#b", "Cs", "C 4", "Bb4.5"} {
+		_, err := ParseNote(name)
+		assert.True(t, errors.Is(err, ErrNote), "%q", name)
+	}
+	assert.Panics(t, func() { MustParseNote("X") })
+}
+
+func TestNoteSpelling(t *testing.T) {
+	// Enharmonic notes are different notes with the same chroma.
+	assert.NotEqual(t, MustParseNote("Fb"), MustParseNote("E"))
+	assert.Equal(t, MustParseNote("Fb").Chroma(), MustParseNote("E").Chroma())
+	assert.Equal(t, 2, MustParseNote("C##").Chroma())
+	assert.Equal(t, 11, MustParseNote("Cb").Chroma())
+	assert.Equal(t, 0, MustParseNote("B#").Chroma())
+
+	assert.Equal(t, MustParseNote("Db5"), NoteOf(Pitch{Step: 1, Alt: -1, Oct: 5, HasOct: true}))
+	assert.Equal(t, Pitch{Step: 1, Alt: -1, Oct: 5, HasOct: true}, MustParseNote("Db5").Pitch())
+	assert.Equal(t, MustParseNote("Db"), MustParseNote("Db5").PitchClass())
+}
+
+func TestMIDIAndFrequency(t *testing.T) {
+	testCases := []struct {
+		name string
+		midi int
+	}{
+		{"C4", 60},
+		{"A4", 69},
+		{"B#3", 60},
+		{"Cb4", 59},
+		{"C-1", 0},
+		{"G9", 127},
+		{"Dbb4", 60},
+	}
+	for _, tc := range testCases {
+		midi, ok := MustParseNote(tc.name).MIDI()
+		assert.True(t, ok, tc.name)
+		assert.Equal(t, tc.midi, midi, tc.name)
+	}
+	_, ok := MustParseNote("C").MIDI()
+	assert.False(t, ok)
+
+	assert.Equal(t, "C#4", FromMIDI(61).String())
+	assert.Equal(t, "B-1", FromMIDI(11).String())
+	assert.Equal(t, "C-2", FromMIDI(-12).String())
+
+	frequency, ok := MustParseNote("A4").Frequency()
+	assert.True(t, ok)
+	assert.Equal(t, 440.0, frequency)
+	frequency, _ = MustParseNote("A5").Frequency()
+	assert.Equal(t, 880.0, frequency)
+	frequency, _ = MustParseNote("C4").Frequency()
+	assert.InDelta(t, 261.626, frequency, 0.001)
+	frequency, _ = MustParseNote("A4").FrequencyAt(442)
+	assert.Equal(t, 442.0, frequency)
+	_, ok = MustParseNote("A").Frequency()
+	assert.False(t, ok)
+
+	n, ok := FromFrequency(261.6)
+	assert.True(t, ok)
+	assert.Equal(t, "C4", n.String())
+	n, _ = FromFrequency(450)
+	assert.Equal(t, "A4", n.String())
+	_, ok = FromFrequency(0)
+	assert.False(t, ok)
+}
+
+func TestConvertNote(t *testing.T) {
+	assert.Equal(t, &note.Note{Class: note.B, Octave: 3}, MustParseNote("Cb4").ToNote())
+	assert.Equal(t, &note.Note{Class: note.C, Octave: 4}, MustParseNote("B#3").ToNote())
+	assert.Equal(t, &note.Note{Class: note.E}, MustParseNote("Fb").ToNote())
+	assert.Equal(t, &note.Note{Class: note.D}, MustParseNote("C##").ToNote())
+	assert.Equal(t, note.Nil, Note{}.ToNote().Class)
+
+	assert.Equal(t, MustParseNote("C#4"), FromNote(&note.Note{Class: note.Cs, Octave: 4}))
+	assert.Equal(t, Note{}, FromNote(nil))
+	assert.Equal(t, MustParseNote("A#"), FromClass(note.As))
+	assert.Equal(t, Note{}, FromClass(note.Nil))
+
+	notes := ToNotes([]Note{MustParseNote("Db"), MustParseNote("F")})
+	assert.Equal(t, []*note.Note{{Class: note.Cs}, {Class: note.F}}, notes)
+}
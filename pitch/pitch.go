@@ -0,0 +1,165 @@
+// Pitches (spelled notes and intervals) as coordinates on the line of fifths.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/pitch/index.ts
+package pitch
+
+type Direction int
+
+const (
+	Ascending  Direction = 1
+	Descending Direction = -1
+)
+
+// Pitch is a spelled note or interval.
+//
+// Step is the letter of a note (0 is C, 6 is B) or the number of an interval
+// minus one (0 is a unison, 6 a seventh). Alt is the number of sharps (negative
+// for flats) of a note, or the alteration of an interval from the major or
+// perfect one. Oct is the octave of a note, or the number of octaves of a
+// compound interval; pitch classes have no octave (HasOct is false). Dir is the
+// direction of an interval and is zero for notes.
+type Pitch struct {
+	Step   int
+	Alt    int
+	Oct    int
+	HasOct bool
+	Dir    Direction
+}
+
+// Coordinates place a pitch on the line of fifths: a number of fifths and a
+// number of octaves, so that its size in semitones (above C0 for a note) is
+// 7 * Fifths + 12 * Octaves. A major third is 4 fifths (C G D A E) minus 2
+// octaves, E4 is 4 fifths and 2 octaves. Pitch classes only have fifths.
+//
+// Descending intervals have negative coordinates; Dir keeps the direction of
+// intervals whose coordinates are zero ("-1P").
+type Coordinates struct {
+	Fifths     int
+	Octaves    int
+	HasOctaves bool
+	Dir        Direction
+}
+
+// Fifths of each step from C, which are also the fifths of the major or perfect
+// interval of each step.
+var stepFifths = []int{0, 2, 4, -1, 1, 3, 5}
+
+// Semitones of each step from C.
+var stepSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+
+// Encode returns the coordinates of a pitch.
+func Encode(p Pitch) Coordinates {
+	fifths := stepFifths[mod(p.Step, 7)] + 7*p.Alt
+	if !p.HasOct {
+		if p.Dir == Descending {
+			fifths = -fifths
+		}
+		return Coordinates{Fifths: fifths, Dir: p.Dir}
+	}
+
+	octaves := (12*p.Oct + stepSemitones[mod(p.Step, 7)] + p.Alt - 7*fifths) / 12
+	if p.Dir == Descending {
+		fifths, octaves = -fifths, -octaves
+	}
+	return Coordinates{Fifths: fifths, Octaves: octaves, HasOctaves: true, Dir: p.Dir}
+}
+
+// Decode returns the pitch of coordinates.
+//
+// Coordinates with a direction are intervals: the direction is the one of the
+// steps (a fifth is four steps, an octave seven), not of the semitones, so that
+// B#3 - C4 is a descending diminished second rather than an ascending one.
+func Decode(c Coordinates) Pitch {
+	fifths, octaves := c.Fifths, c.Octaves
+	if c.Dir == 0 {
+		step := mod(fifths*4, 7)
+		alt := floorDiv(fifths+1, 7)
+		p := Pitch{Step: step, Alt: alt}
+		if c.HasOctaves {
+			p.HasOct = true
+			p.Oct = floorDiv(7*fifths+12*octaves-stepSemitones[step]-alt, 12)
+		}
+		return p
+	}
+
+	dir := c.Dir
+	if !c.HasOctaves {
+		if dir == Descending {
+			fifths = -fifths
+		}
+		return Pitch{Step: mod(fifths*4, 7), Alt: floorDiv(fifths+1, 7), Dir: dir}
+	}
+
+	steps := 4*fifths + 7*octaves
+	switch {
+	case steps > 0:
+		dir = Ascending
+	case steps < 0:
+		dir = Descending
+	}
+	if dir == Descending {
+		fifths, steps = -fifths, -steps
+	}
+	return Pitch{
+		Step:   steps % 7,
+		Alt:    floorDiv(fifths+1, 7),
+		Oct:    steps / 7,
+		HasOct: true,
+		Dir:    dir,
+	}
+}
+
+// Chroma returns the pitch class (0 to 11, 0 is C) of the coordinates.
+func (c Coordinates) Chroma() int {
+	return mod(7*c.Fifths, 12)
+}
+
+// Semitones returns the size in semitones of the coordinates of an interval,
+// or the height above C0 of a note. Pitch classes are in octave 0.
+func (c Coordinates) Semitones() int {
+	return 7*c.Fifths + 12*c.Octaves
+}
+
+// Add returns the coordinates of a note or interval moved by an interval.
+func (c Coordinates) Add(interval Coordinates) Coordinates {
+	c.Fifths += interval.Fifths
+	c.Octaves += interval.Octaves
+	return c
+}
+
+// Subtract returns the coordinates of the interval from other to c.
+func (c Coordinates) Subtract(other Coordinates) Coordinates {
+	return Coordinates{
+		Fifths:     c.Fifths - other.Fifths,
+		Octaves:    c.Octaves - other.Octaves,
+		HasOctaves: c.HasOctaves && other.HasOctaves,
+		Dir:        Ascending,
+	}
+}
+
+// Respell moves the coordinates by twelve fifths (a Pythagorean comma, no
+// change in equal temperament) until the fifths are in [lowest, lowest + 12):
+// with lowest -6, A# (10 fifths) is respelled Bb (-2 fifths).
+func (c Coordinates) Respell(lowest int) Coordinates {
+	shift := floorDiv(c.Fifths-lowest, 12)
+	c.Fifths -= 12 * shift
+	// 12 fifths are 84 semitones, 7 octaves.
+	c.Octaves += 7 * shift
+	return c
+}
+
+// Chroma returns the pitch class (0 to 11, 0 is C) of a note, or the size of an
+// interval reduced to one octave.
+func (p Pitch) Chroma() int {
+	return Encode(p).Chroma()
+}
+
+func mod(n int, m int) int {
+	return ((n % m) + m) % m
+}
+
+func floorDiv(a int, b int) int {
+	if (a < 0) != (b < 0) && a%b != 0 {
+		return a/b - 1
+	}
+	return a / b
+}
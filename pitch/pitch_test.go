@@ -0,0 +1,71 @@
+package pitch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pitch    Pitch
+		expected Coordinates
+	}{
+		{"C", Pitch{Step: 0}, Coordinates{Fifths: 0}},
+		{"F#", Pitch{Step: 3, Alt: 1}, Coordinates{Fifths: 6}},
+		{"Bb", Pitch{Step: 6, Alt: -1}, Coordinates{Fifths: -2}},
+		{"E4", Pitch{Step: 2, Oct: 4, HasOct: true}, Coordinates{Fifths: 4, Octaves: 2, HasOctaves: true}},
+		{"B#3", Pitch{Step: 6, Alt: 1, Oct: 3, HasOct: true}, Coordinates{Fifths: 12, Octaves: -3, HasOctaves: true}},
+		{"3M", Pitch{Step: 2, HasOct: true, Dir: Ascending}, Coordinates{Fifths: 4, Octaves: -2, HasOctaves: true, Dir: Ascending}},
+		{"5P", Pitch{Step: 4, HasOct: true, Dir: Ascending}, Coordinates{Fifths: 1, Octaves: 0, HasOctaves: true, Dir: Ascending}},
+		{"9M", Pitch{Step: 1, Oct: 1, HasOct: true, Dir: Ascending}, Coordinates{Fifths: 2, Octaves: 0, HasOctaves: true, Dir: Ascending}},
+		{"-2M", Pitch{Step: 1, HasOct: true, Dir: Descending}, Coordinates{Fifths: -2, Octaves: 1, HasOctaves: true, Dir: Descending}},
+		{"-1P", Pitch{Step: 0, HasOct: true, Dir: Descending}, Coordinates{HasOctaves: true, Dir: Descending}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Encode(tc.pitch), tc.name)
+		assert.Equal(t, tc.pitch, Decode(tc.expected), tc.name)
+	}
+}
+
+func TestDecodeDirection(t *testing.T) {
+	// From C4 to B#3 is 12 fifths and -7 octaves: zero semitones, but one
+	// step down.
+	p := Decode(Coordinates{Fifths: 12, Octaves: -7, HasOctaves: true, Dir: Ascending})
+	assert.Equal(t, Pitch{Step: 1, Alt: -2, HasOct: true, Dir: Descending}, p)
+}
+
+func TestSemitonesAndChroma(t *testing.T) {
+	e4 := Encode(Pitch{Step: 2, Oct: 4, HasOct: true})
+	assert.Equal(t, 52, e4.Semitones())
+	assert.Equal(t, 4, e4.Chroma())
+
+	tenth := Encode(Pitch{Step: 2, Oct: 1, HasOct: true, Dir: Ascending})
+	assert.Equal(t, 16, tenth.Semitones())
+	assert.Equal(t, 4, Pitch{Step: 2, Oct: 1, HasOct: true, Dir: Ascending}.Chroma())
+
+	assert.Equal(t, 10, Coordinates{Fifths: -2}.Chroma())
+	assert.Equal(t, -2, Encode(Pitch{Step: 1, HasOct: true, Dir: Descending}).Semitones())
+}
+
+func TestAddAndSubtract(t *testing.T) {
+	c4 := Encode(Pitch{Oct: 4, HasOct: true})
+	third := Encode(Pitch{Step: 2, HasOct: true, Dir: Ascending})
+	assert.Equal(t, Pitch{Step: 2, Oct: 4, HasOct: true}, Decode(c4.Add(third)))
+
+	g3 := Encode(Pitch{Step: 4, Oct: 3, HasOct: true})
+	assert.Equal(t, Pitch{Step: 3, HasOct: true, Dir: Descending}, Decode(g3.Subtract(c4)))
+}
+
+func TestRespell(t *testing.T) {
+	aSharp := Coordinates{Fifths: 10}
+	assert.Equal(t, Pitch{Step: 6, Alt: -1}, Decode(aSharp.Respell(-6)))
+
+	// Respelling keeps the pitch.
+	aSharp4 := Encode(Pitch{Step: 5, Alt: 1, Oct: 4, HasOct: true})
+	bFlat4 := aSharp4.Respell(-6)
+	assert.Equal(t, aSharp4.Semitones(), bFlat4.Semitones())
+	assert.Equal(t, Pitch{Step: 6, Alt: -1, Oct: 4, HasOct: true}, Decode(bFlat4))
+	assert.Equal(t, aSharp4, aSharp4.Respell(0))
+}
@@ -0,0 +1,150 @@
+package pitchinterval
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/pitch"
+)
+
+// Intervals and notes are added with their coordinates on the line of fifths
+// (see the pitch package).
+
+// Fifths returns the position of the interval on the line of fifths, ignoring
+// octaves: a perfect fifth is 1, a major second is 2 and a minor third is -3.
+// Descending intervals are negative: "-5P" is -1.
+func (i Interval) Fifths() int {
+	return i.Coord.Fifths
+}
+
+// FromCoordinates returns the interval of coordinates on the line of fifths, or
+// Nointerval if its quality would be beyond four augmentations or diminutions.
+// Coordinates without octaves give a simple interval.
+func FromCoordinates(coord pitch.Coordinates) Interval {
+	if coord.Dir == 0 {
+		coord.Dir = pitch.Ascending
+	}
+	p := pitch.Decode(coord)
+	q, ok := altToQ(types[p.Step], p.Alt)
+	if !ok {
+		return Nointerval
+	}
+	return Parse(strconv.Itoa(int(p.Dir)*(p.Step+1+7*p.Oct)) + string(q))
+}
+
+func altToQ(t string, alt int) (Quaility, bool) {
+	if t == "M" {
+		switch {
+		case alt == 0:
+			return M, true
+		case alt == -1:
+			return m, true
+		case alt < -1 && alt >= -5:
+			return Quaility(strings.Repeat("d", -alt-1)), true
+		}
+	} else {
+		switch {
+		case alt == 0:
+			return P, true
+		case alt < 0 && alt >= -4:
+			return Quaility(strings.Repeat("d", -alt)), true
+		}
+	}
+	if alt > 0 && alt <= 4 {
+		return Quaility(strings.Repeat("A", alt)), true
+	}
+	return "", false
+}
+
+// Add returns the sum of two intervals: "3m" + "5P" is "7m".
+// Returns Nointerval if one of them is empty.
+func Add(a Interval, b Interval) Interval {
+	if a.Empty || b.Empty {
+		return Nointerval
+	}
+	return FromCoordinates(a.Coord.Add(b.Coord))
+}
+
+// Subtract returns the interval from b to a: "5P" - "3M" is "3m".
+func Subtract(a Interval, b Interval) Interval {
+	if a.Empty || b.Empty {
+		return Nointerval
+	}
+	return FromCoordinates(a.Coord.Subtract(b.Coord))
+}
+
+// Invert returns the inversion of an interval within the octave: "3m" is "6M",
+// "-2M" is "-7m". Compound intervals keep their octaves: "9M" is "14m".
+func Invert(i Interval) Interval {
+	if i.Empty {
+		return Nointerval
+	}
+	step := (7 - i.Step) % 7
+	alt := -i.Alt
+	if types[i.Step] == "M" {
+		alt = -i.Alt - 1
+	}
+	q, ok := altToQ(types[step], alt)
+	if !ok {
+		return Nointerval
+	}
+	dir := 1
+	if i.Num < 0 {
+		dir = -1
+	}
+	return Parse(strconv.Itoa(dir*(step+1+7*i.Oct)) + string(q))
+}
+
+// Simplify reduces a compound interval to less than an octave, keeping its
+// direction: "9M" is "2M", "-10m" is "-3m". Octaves stay octaves: "15P" is "8P".
+func Simplify(i Interval) Interval {
+	if i.Empty {
+		return Nointerval
+	}
+	num := i.Step + 1
+	if i.Step == 0 && i.Oct > 0 {
+		num = 8
+	}
+	if i.Num < 0 {
+		num = -num
+	}
+	return Parse(strconv.Itoa(num) + string(i.Q))
+}
+
+// Preferred spelling of the intervals of each size in semitones.
+var preferred = []string{"1P", "2m", "2M", "3m", "3M", "4P", "5d", "5P", "6m", "6M", "7m", "7M"}
+
+// FromSemitones returns an interval of a number of semitones, with the usual
+// spelling: 6 is "5d" (not "4A"), 14 is "9M", -7 is "-5P".
+func FromSemitones(semitones int) Interval {
+	dir := 1
+	if semitones < 0 {
+		dir = -1
+		semitones = -semitones
+	}
+	simple := Parse(preferred[semitones%12])
+	return Parse(strconv.Itoa(dir*(simple.Num+7*(semitones/12))) + string(simple.Q))
+}
+
+// FromSemitonesAs is like FromSemitones, spelled as the given interval number
+// (1 to 7, or compound and negative numbers): FromSemitonesAs(6, 4) is "4A",
+// FromSemitonesAs(3, 2) is "2A".
+//
+// Returns Nointerval if the number can't be spelled with that many semitones.
+func FromSemitonesAs(semitones int, num int) Interval {
+	if num == 0 {
+		return Nointerval
+	}
+	dir := 1
+	if num < 0 {
+		dir = -1
+	}
+	step := (dir*num - 1) % 7
+	oct := (dir*num - 1) / 7
+	alt := dir*semitones - sizes[step] - 12*oct
+	q, ok := altToQ(types[step], alt)
+	if !ok {
+		return Nointerval
+	}
+	return Parse(strconv.Itoa(num) + string(q))
+}
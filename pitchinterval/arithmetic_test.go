@@ -0,0 +1,112 @@
+package pitchinterval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected string
+	}{
+		{"3m", "5P", "7m"},
+		{"3M", "3m", "5P"},
+		{"5P", "5P", "9M"},
+		{"4P", "5P", "8P"},
+		{"3M", "3M", "5A"},
+		{"2m", "2m", "3d"},
+		{"5P", "-3M", "3m"},
+		{"3M", "-5P", "-3m"},
+		{"8P", "-8P", "1P"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Add(Parse(tc.a), Parse(tc.b)).Name, "%s + %s", tc.a, tc.b)
+	}
+	assert.True(t, Add(Parse("3M"), Nointerval).Empty)
+	assert.True(t, Add(Parse("1AAAA"), Parse("1AAAA")).Empty, "Beyond four augmentations")
+}
+
+func TestSubtract(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected string
+	}{
+		{"5P", "3M", "3m"},
+		{"8P", "3m", "6M"},
+		{"3M", "5P", "-3m"},
+		{"9M", "2M", "8P"},
+		{"2M", "2M", "1P"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Subtract(Parse(tc.a), Parse(tc.b)).Name, "%s - %s", tc.a, tc.b)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"1P", "1P"},
+		{"2M", "7m"},
+		{"3m", "6M"},
+		{"4P", "5P"},
+		{"4A", "5d"},
+		{"6d", "3A"},
+		{"7M", "2m"},
+		{"-2M", "-7m"},
+		{"9M", "14m"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Invert(Parse(tc.input)).Name, "Input: %s", tc.input)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"2M", "2M"},
+		{"9M", "2M"},
+		{"11A", "4A"},
+		{"-10m", "-3m"},
+		{"8P", "8P"},
+		{"15P", "8P"},
+		{"-8P", "-8P"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Simplify(Parse(tc.input)).Name, "Input: %s", tc.input)
+	}
+}
+
+func TestFromSemitones(t *testing.T) {
+	names := make([]string, 0)
+	for semitones := 0; semitones <= 12; semitones++ {
+		names = append(names, FromSemitones(semitones).Name)
+	}
+	assert.Equal(t, []string{"1P", "2m", "2M", "3m", "3M", "4P", "5d", "5P", "6m", "6M", "7m", "7M", "8P"}, names)
+	assert.Equal(t, "9M", FromSemitones(14).Name)
+	assert.Equal(t, "-5P", FromSemitones(-7).Name)
+	assert.Equal(t, "-9m", FromSemitones(-13).Name)
+	assert.Equal(t, 14, FromSemitones(14).Semitones)
+
+	assert.Equal(t, "4A", FromSemitonesAs(6, 4).Name)
+	assert.Equal(t, "2A", FromSemitonesAs(3, 2).Name)
+	assert.Equal(t, "3d", FromSemitonesAs(2, 3).Name)
+	assert.Equal(t, "11A", FromSemitonesAs(18, 11).Name)
+	assert.Equal(t, "-4A", FromSemitonesAs(-6, -4).Name)
+	assert.True(t, FromSemitonesAs(11, 2).Empty)
+	assert.True(t, FromSemitonesAs(3, 0).Empty)
+}
+
+func TestFifths(t *testing.T) {
+	assert.Equal(t, 1, Parse("5P").Fifths())
+	assert.Equal(t, 2, Parse("2M").Fifths())
+	assert.Equal(t, -3, Parse("3m").Fifths())
+	assert.Equal(t, 6, Parse("4A").Fifths())
+	assert.Equal(t, -1, Parse("-5P").Fifths())
+	assert.Equal(t, 2, Parse("9M").Fifths())
+}
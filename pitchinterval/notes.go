@@ -0,0 +1,37 @@
+package pitchinterval
+
+import (
+	"github.com/Golevka2001/go-chord-detector/pitch"
+)
+
+// Notes are written like pitch.ParseNote reads them: "C", "Bb", "F#4", "Ebb-1".
+// Notes without an octave are pitch classes.
+
+// Distance returns the interval between two notes. With octaves, the interval
+// can be compound or descending: "C4" to "E5" is "10M", "C4" to "G3" is "-4P".
+// Without octaves, it is the simple ascending interval: "E" to "C" is "6m".
+//
+// Returns Nointerval if one of the notes is invalid.
+func Distance(from string, to string) Interval {
+	a, errFrom := pitch.ParseNote(from)
+	b, errTo := pitch.ParseNote(to)
+	if errFrom != nil || errTo != nil {
+		return Nointerval
+	}
+	if !a.HasOct || !b.HasOct {
+		a, b = a.PitchClass(), b.PitchClass()
+	}
+	return FromCoordinates(b.Coordinates().Subtract(a.Coordinates()))
+}
+
+// TransposeNote moves a note by an interval: "C4" up "3M" is "E4", "Bb" up "2M"
+// is "C", "F#3" up "-9m" is "E#2". Accidentals are written "#" and "b".
+//
+// Returns an empty string if the note or the interval is invalid.
+func TransposeNote(name string, interval Interval) string {
+	n, err := pitch.ParseNote(name)
+	if err != nil || interval.Empty {
+		return ""
+	}
+	return pitch.NoteOf(pitch.Decode(n.Coordinates().Add(interval.Coord))).String()
+}
@@ -0,0 +1,66 @@
+package pitchinterval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	testCases := []struct {
+		from, to string
+		expected string
+	}{
+		{"C4", "G4", "5P"},
+		{"C4", "E5", "10M"},
+		{"C4", "G3", "-4P"},
+		{"C4", "C4", "1P"},
+		{"C4", "C5", "8P"},
+		{"B3", "C4", "2m"},
+		{"C", "G", "5P"},
+		{"E", "C", "6m"},
+		{"C", "B#", "7A"},
+		{"F#", "Bb", "4d"},
+		{"C#4", "Db4", "2d"},
+		{"Cb4", "B#3", "-2dd"},
+		{"C-1", "C0", "8P"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Distance(tc.from, tc.to).Name, "%s to %s", tc.from, tc.to)
+	}
+	assert.True(t, Distance("H", "C").Empty)
+	assert.True(t, Distance("C4", "c4").Empty)
+}
+
+func TestTransposeNote(t *testing.T) {
+	testCases := []struct {
+		note     string
+		interval string
+		expected string
+	}{
+		{"C4", "3M", "E4"},
+		{"C4", "5P", "G4"},
+		{"C4", "9m", "Db5"},
+		{"B3", "2m", "C4"},
+		{"C4", "-2M", "Bb3"},
+		{"F#3", "-9m", "E#2"},
+		{"Bb", "2M", "C"},
+		{"E", "3M", "G#"},
+		{"G#", "3M", "B#"},
+		{"B#", "3M", "D##"},
+		{"Dbb", "2m", "Ebbb"},
+		{"Cx4", "1P", "C##4"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, TransposeNote(tc.note, Parse(tc.interval)), "%s + %s", tc.note, tc.interval)
+	}
+	assert.Equal(t, "", TransposeNote("X", Parse("3M")))
+	assert.Equal(t, "", TransposeNote("C", Nointerval))
+
+	for _, note := range []string{"C4", "Eb2", "F#-1", "G##5"} {
+		for _, interval := range []string{"2m", "3M", "-6m", "11A", "-15P"} {
+			moved := TransposeNote(note, Parse(interval))
+			assert.Equal(t, interval, Distance(note, moved).Name, "%s + %s = %s", note, interval, moved)
+		}
+	}
+}
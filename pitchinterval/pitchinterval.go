@@ -2,10 +2,13 @@
 package pitchinterval
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"regexp"
 	"strconv"
+
+	"github.com/Golevka2001/go-chord-detector/pitch"
 )
 
 type Quaility string
@@ -31,22 +34,27 @@ const (
 	majorable   Type = "majorable"
 )
 
+// Interval is a spelled interval.
+//
+// Coord is the position of the interval on the line of fifths, with its
+// direction (see the pitch package): transposition and enharmonic spelling use
+// it rather than the semitones.
 type Interval struct {
 	// Pitch
 	// NamedPitch
-	Empty bool
-	Name  string
-	Num   int
-	Q     Quaility
-	T     Type
-	Step  int
-	Alt   int
-	// Dir Direction
+	Empty     bool
+	Name      string
+	Num       int
+	Q         Quaility
+	T         Type
+	Step      int
+	Alt       int
+	Dir       pitch.Direction
 	Simple    int
 	Semitones int
 	Chroma    int
-	// Coord IntervalCoordinates
-	Oct int
+	Coord     pitch.Coordinates
+	Oct       int
 }
 
 var Nointerval = Interval{
@@ -57,9 +65,11 @@ var Nointerval = Interval{
 	T:         "",
 	Step:      0,
 	Alt:       0,
+	Dir:       0,
 	Simple:    0,
 	Semitones: 0,
 	Chroma:    0,
+	Coord:     pitch.Coordinates{},
 	Oct:       0,
 }
 
@@ -85,20 +95,76 @@ func TokenizeInterval(str string) [2]string {
 var sizes = []int{0, 2, 4, 5, 7, 9, 11}
 var types = []string{"P", "M", "M", "P", "P", "M", "M"}
 
+// Larger interval numbers would overflow the semitones.
+const maxNumber = 1 << 24
+
+// Parse returns the interval of a name ("3M", "-5P", "M9"), or Nointerval if the
+// name is not a valid interval. Use ParseInterval to know why.
 func Parse(str string) Interval {
-	tokens := TokenizeInterval(str)
-	if tokens[0] == "" {
-		return Nointerval
-	}
-	num, err := strconv.Atoi(tokens[0])
+	interval, err := ParseInterval(str)
 	if err != nil {
 		return Nointerval
 	}
-	q := Quaility(tokens[1])
+	return interval
+}
+
+var (
+	ErrSyntax   = errors.New("not an interval")
+	ErrQuality  = errors.New("invalid quality")
+	ErrZero     = errors.New("interval number can't be zero")
+	ErrMismatch = errors.New("quality doesn't match the interval number")
+)
+
+// ParseError reports why a name is not an interval. Err is one of ErrSyntax,
+// ErrQuality, ErrZero or ErrMismatch ("2P", "5M": seconds, thirds, sixths and
+// sevenths are major or minor, unisons, fourths and fifths are perfect).
+type ParseError struct {
+	Input string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("pitchinterval: %q: %s", e.Input, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Loose forms of the two notations, to tell a bad quality from a bad syntax.
+var (
+	numberFirstRegex  = regexp.MustCompile(`^([-+]?\d+)([A-Za-z]+)$`)
+	qualityFirstRegex = regexp.MustCompile(`^([A-Za-z]+)([-+]?\d+)$`)
+	qualityRegex      = regexp.MustCompile(`^(d{1,4}|m|M|P|A{1,4})$`)
+)
+
+// ParseInterval is like Parse, with an error (a *ParseError) for invalid names.
+func ParseInterval(str string) (Interval, error) {
+	var numStr, qStr string
+	if matches := numberFirstRegex.FindStringSubmatch(str); matches != nil {
+		numStr, qStr = matches[1], matches[2]
+	} else if matches := qualityFirstRegex.FindStringSubmatch(str); matches != nil {
+		qStr, numStr = matches[1], matches[2]
+	} else {
+		return Nointerval, &ParseError{Input: str, Err: ErrSyntax}
+	}
+
+	if !qualityRegex.MatchString(qStr) {
+		return Nointerval, &ParseError{Input: str, Err: ErrQuality}
+	}
+	num, err := strconv.Atoi(numStr)
+	if err != nil || num > maxNumber || num < -maxNumber {
+		return Nointerval, &ParseError{Input: str, Err: ErrSyntax}
+	}
+	if num == 0 {
+		return Nointerval, &ParseError{Input: str, Err: ErrZero}
+	}
+
+	q := Quaility(qStr)
 	step := int(math.Abs(float64(num))-1) % 7
 	t := types[step]
-	if t == "M" && q == "P" {
-		return Nointerval
+	if (t == "M" && q == "P") || (t == "P" && (q == "M" || q == "m")) {
+		return Nointerval, &ParseError{Input: str, Err: ErrMismatch}
 	}
 	var tp Type
 	if t == "M" {
@@ -107,43 +173,42 @@ func Parse(str string) Interval {
 		tp = perfectable
 	}
 	name := fmt.Sprintf("%d%s", num, q)
-	var dir int
+	dir := pitch.Ascending
 	if num < 0 {
-		dir = -1
-	} else {
-		dir = 1
+		dir = pitch.Descending
 	}
 	var simple int
 	if num == 8 || num == -8 {
 		simple = num
 	} else {
-		simple = dir * (step + 1)
+		simple = int(dir) * (step + 1)
 	}
 	alt := qToAlt(tp, q)
 	oct := int(math.Floor((math.Abs(float64(num)) - 1) / 7))
-	semitones := dir * (sizes[step] + alt + 12*oct)
-	chroma := (((dir * (sizes[step] + alt)) % 12) + 12) % 12
-	// coord := pitch.coordinates(pitch.Pitch{
-	// 	Step: step,
-	// 	Alt:  alt,
-	// 	Oct:  oct,
-	// 	Dir:  dir,
-	// })
+	semitones := int(dir) * (sizes[step] + alt + 12*oct)
+	chroma := (((int(dir) * (sizes[step] + alt)) % 12) + 12) % 12
+	coord := pitch.Encode(pitch.Pitch{
+		Step:   step,
+		Alt:    alt,
+		Oct:    oct,
+		HasOct: true,
+		Dir:    dir,
+	})
 	return Interval{
-		Empty: false,
-		Name:  name,
-		Num:   num,
-		Q:     q,
-		T:     tp,
-		Step:  step,
-		Alt:   alt,
-		// Dir:    dir,
+		Empty:     false,
+		Name:      name,
+		Num:       num,
+		Q:         q,
+		T:         tp,
+		Step:      step,
+		Alt:       alt,
+		Dir:       dir,
 		Simple:    simple,
 		Semitones: semitones,
 		Chroma:    chroma,
-		// Coord:     coord,
-		Oct: oct,
-	}
+		Coord:     coord,
+		Oct:       oct,
+	}, nil
 }
 
 func qToAlt(t Type, q Quaility) int {
@@ -4,6 +4,7 @@ package pitchinterval
 import (
 	"testing"
 
+	"github.com/Golevka2001/go-chord-detector/pitch"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,7 +40,9 @@ func TestIntervalFromString(t *testing.T) {
 			Q:         d,
 			T:         perfectable,
 			Alt:       -1,
+			Dir:       pitch.Ascending,
 			Chroma:    4,
+			Coord:     pitch.Coordinates{Fifths: -8, Octaves: 5, HasOctaves: true, Dir: pitch.Ascending},
 			Simple:    4,
 			Step:      3,
 			Semitones: 4,
@@ -199,3 +202,65 @@ func TestNoInterval(t *testing.T) {
 		assert.Equal(t, 0, Nointerval.Oct)
 	})
 }
+
+func TestParseInterval(t *testing.T) {
+	interval, err := ParseInterval("-9m")
+	assert.NoError(t, err)
+	assert.Equal(t, Parse("-9m"), interval)
+
+	testCases := []struct {
+		input    string
+		expected error
+	}{
+		{"", ErrSyntax},
+		{"blah", ErrSyntax},
+		{"3", ErrSyntax},
+		{"M", ErrSyntax},
+		{"5P ", ErrSyntax},
+		{"99999999999999999999M", ErrSyntax},
+		{"3X", ErrQuality},
+		{"3mm", ErrQuality},
+		{"AAAAA4", ErrQuality},
+		{"0M", ErrZero},
+		{"P-0", ErrZero},
+		{"2P", ErrMismatch},
+		{"5M", ErrMismatch},
+		{"4m", ErrMismatch},
+	}
+	for _, tc := range testCases {
+		interval, err := ParseInterval(tc.input)
+		assert.True(t, interval.Empty, "Input: %q", tc.input)
+		assert.ErrorIs(t, err, tc.expected, "Input: %q", tc.input)
+		var parseError *ParseError
+		if assert.ErrorAs(t, err, &parseError) {
+			assert.Equal(t, tc.input, parseError.Input)
+		}
+		assert.True(t, Parse(tc.input).Empty, "Input: %q", tc.input)
+	}
+	_, err = ParseInterval("0M")
+	assert.EqualError(t, err, `pitchinterval: "0M": interval number can't be zero`)
+}
+
+func FuzzParseInterval(f *testing.F) {
+	for _, seed := range []string{"1P", "-9m", "M3", "0M", "2P", "AAAA4", "dddd-15", "", "P-0"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		interval, err := ParseInterval(input)
+		if err != nil {
+			if !interval.Empty {
+				t.Errorf("%q: error with a non-empty interval", input)
+			}
+			return
+		}
+		again, err := ParseInterval(interval.Name)
+		if err != nil || again != interval {
+			t.Errorf("%q: %q doesn't parse back to the same interval", input, interval.Name)
+		}
+		Invert(interval)
+		Simplify(interval)
+		Add(interval, interval)
+		Subtract(interval, interval)
+		TransposeNote("C4", interval)
+	})
+}
@@ -0,0 +1,165 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Polychord is a chord of many notes split into a lower chord, which has the
+// bass, and an upper chord: "C E G Bb D F# A" is a D triad over a C7.
+//
+// Name is the polychord symbol ("D|C7") and Slash the upper structure symbol
+// ("D/C7"). Upper is named without a bass, whatever its inversion. Weight is the
+// weight of Lower, halved when Upper is not a major or minor triad, the usual
+// upper structures.
+type Polychord struct {
+	Weight float64
+	Name   string
+	Slash  string
+	Upper  FoundChord
+	Lower  FoundChord
+}
+
+// DetectPolychords returns the polychords of the notes, the heaviest first.
+//
+// When the notes have octaves, the lower chord is the lowest notes and the
+// upper chord the highest ones, and they can share pitch classes. Otherwise, the
+// notes are split in every way into two chords that share no pitch class, the
+// first note being the bass of the lower chord. Both chords are named with the
+// chord type dictionary and the options, and have at least two and three pitch
+// classes. Rootless chords and chords over a foreign bass are left out: every
+// note of the polychord is a tone of one of its chords.
+func DetectPolychords(source []*note.Note, options DetectOptions) []Polychord {
+	return detectPolychords(source, sharpSpelling, options)
+}
+
+// DetectPitchPolychords is like DetectPolychords for spelled notes.
+func DetectPitchPolychords(notes []pitch.Note, options DetectOptions) []Polychord {
+	return detectPolychords(pitch.ToNotes(notes), spellingOf(notes), options)
+}
+
+// upperWeight multiplies the weight of a polychord whose upper chord is not a
+// major or minor triad.
+const upperWeight = 0.5
+
+func detectPolychords(source []*note.Note, names spelling, options DetectOptions) []Polychord {
+	result := make([]Polychord, 0)
+	for _, split := range splits(source) {
+		lowers := detectChords(split[0], names, options)
+		uppers := upperChords(split[1], names, options)
+		for _, lower := range lowers {
+			if lower.Rootless || lower.ForeignBass {
+				continue
+			}
+			for _, upper := range uppers {
+				polychord := Polychord{
+					Weight: lower.Weight,
+					Name:   upper.Name + "|" + lower.Name,
+					Slash:  upper.Name + "/" + lower.Name,
+					Upper:  upper,
+					Lower:  lower,
+				}
+				if !isUpperTriad(upper.ChordType) {
+					polychord.Weight *= upperWeight
+				}
+				result = append(result, polychord)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Weight > result[j].Weight
+	})
+	return result
+}
+
+func isUpperTriad(chordType chordtype.ChordType) bool {
+	if len(chordType.Intervals) != 3 || !containsString(chordType.Intervals, "5P") {
+		return false
+	}
+	return chordType.Quality == chordtype.Major || chordType.Quality == chordtype.Minor
+}
+
+// upperChords returns the chords of the notes in root position, whatever the
+// bass, once each.
+func upperChords(notes []*note.Note, names spelling, options DetectOptions) []FoundChord {
+	options.Rootless = RootlessOptions{}
+	options.ForeignBass = false
+	result := make([]FoundChord, 0)
+	seen := make(map[string]bool)
+	for _, chord := range detectChords(notes, names, options) {
+		name := options.chordName(chord.Root, chord.ChordType, chord.Omitted, "")
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		chord.Name, chord.Bass, chord.Weight = name, "", 1.0
+		result = append(result, chord)
+	}
+	return result
+}
+
+// splits returns the ways to split the notes into lower and upper notes.
+func splits(source []*note.Note) [][2][]*note.Note {
+	notes := make([]*note.Note, 0, len(source))
+	for _, n := range source {
+		if n != nil && n.Class != note.Nil {
+			notes = append(notes, n)
+		}
+	}
+	result := make([][2][]*note.Note, 0)
+	if len(notes) == 0 {
+		return result
+	}
+
+	hasOctaves := false
+	for _, n := range notes {
+		hasOctaves = hasOctaves || n.Octave != 0
+	}
+	if hasOctaves {
+		sort.SliceStable(notes, func(i, j int) bool {
+			return height(notes[i]) < height(notes[j])
+		})
+		for i := 1; i < len(notes); i++ {
+			lower, upper := notes[:i], notes[i:]
+			if pcset.NotesToSet(lower).Len() >= 2 && pcset.NotesToSet(upper).Len() >= 3 {
+				result = append(result, [2][]*note.Note{lower, upper})
+			}
+		}
+		return result
+	}
+
+	// The pitch classes above the bass, once each.
+	bass := notes[0]
+	set := pcset.NotesToSet(notes)
+	var others []*note.Note
+	for _, pc := range set.Rotate(int(bass.Class) - 1).PitchClasses() {
+		if pc != 0 {
+			others = append(others, &note.Note{Class: note.Class((pc+int(bass.Class)-1)%12 + 1)})
+		}
+	}
+	for mask := 1; mask < 1<<len(others); mask++ {
+		lower := []*note.Note{bass}
+		var upper []*note.Note
+		for i, n := range others {
+			if mask&(1<<i) != 0 {
+				upper = append(upper, n)
+			} else {
+				lower = append(lower, n)
+			}
+		}
+		if len(lower) >= 2 && len(upper) >= 3 {
+			result = append(result, [2][]*note.Note{lower, upper})
+		}
+	}
+	return result
+}
+
+// height returns the number of semitones of a note above C0.
+func height(n *note.Note) int {
+	return int(n.Octave)*12 + int(n.Class) - 1
+}
@@ -0,0 +1,248 @@
+// Roman numeral analysis of chords in a key.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/roman-numeral/index.ts
+package romannumeral
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+	"github.com/Golevka2001/go-chord-detector/key"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+)
+
+// Numeral is the function of a chord in a key.
+//
+// Degree (1 to 7) and Alt (-1 for "bVII") locate the root of the chord in the
+// key, or in the key of the target for a secondary function. Roman is the numeral
+// in upper case for chords with a major third and in lower case for chords with a
+// minor third. Quality is "°" (diminished), "ø" (half-diminished), "+" (augmented),
+// "M" (major seventh) or empty. Figure is the inversion figure ("6", "6/4", "7",
+// "6/5", "4/3", "4/2"). Secondary is the numeral of the target of a secondary
+// function ("V" in "V7/V"), empty otherwise.
+type Numeral struct {
+	Empty     bool
+	Name      string
+	Degree    int
+	Alt       int
+	Roman     string
+	Quality   string
+	Figure    string
+	Secondary string
+}
+
+var NoNumeral = Numeral{
+	Empty:     true,
+	Name:      "",
+	Degree:    0,
+	Alt:       0,
+	Roman:     "",
+	Quality:   "",
+	Figure:    "",
+	Secondary: "",
+}
+
+var romans = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+var lowerRomans = []string{"i", "ii", "iii", "iv", "v", "vi", "vii"}
+
+// Analyze returns the roman numeral of a chord in a key.
+//
+// Chords that don't belong to the key and act as a dominant (major triad or
+// dominant seventh) or a leading-tone chord (diminished or half-diminished) of
+// another degree of the key are analysed as secondary functions ("V7/V",
+// "viiø7/ii"). Other chords are numbered from the scale of the key, with
+// alterations ("bVII", "bII"). In minor keys the raised sixth and seventh
+// degrees are diatonic, so the dominant is "V" and the leading-tone chord "vii°".
+//
+// Returns NoNumeral if the key or the chord is empty or unknown.
+func Analyze(k key.Key, c chord.Chord) Numeral {
+	tonic, okTonic := chord.NoteFifths(k.Tonic)
+	root, okRoot := chord.NoteFifths(c.Tonic)
+	if k.Empty || !c.Known() || !okTonic || !okRoot {
+		return NoNumeral
+	}
+	shape := analyzeShape(c)
+
+	numeral := Numeral{Empty: false}
+	if target, ok := secondaryTarget(k, root-tonic, shape); ok && !isDiatonic(k, root-tonic, shape) {
+		numeral.Secondary = target
+		if shape.leadingTone {
+			numeral.Degree = 7
+		} else {
+			numeral.Degree = 5
+		}
+	} else {
+		degree, alt, _ := k.DegreeOf(root - tonic)
+		numeral.Degree = degree
+		numeral.Alt = alt
+		// The leading tone of minor keys is part of the key.
+		if k.Mode == key.Minor && degree == 7 && alt == 1 {
+			numeral.Alt = 0
+		}
+	}
+
+	if shape.minorThird {
+		numeral.Roman = lowerRomans[numeral.Degree-1]
+	} else {
+		numeral.Roman = romans[numeral.Degree-1]
+	}
+	numeral.Quality = shape.quality()
+	numeral.Figure = shape.figure(c, root)
+	numeral.Name = numeral.String()
+	return numeral
+}
+
+// String returns the numeral, e.g. "bVII", "V6/5" or "viiø7/ii".
+func (n Numeral) String() string {
+	if n.Empty {
+		return ""
+	}
+	name := ""
+	switch {
+	case n.Alt < 0:
+		name = strings.Repeat("b", -n.Alt)
+	case n.Alt > 0:
+		name = strings.Repeat("#", n.Alt)
+	}
+	name += n.Roman + n.Quality + n.Figure
+	if n.Secondary != "" {
+		name += "/" + n.Secondary
+	}
+	return name
+}
+
+// shape is what matters of the intervals of a chord type for its numeral.
+type shape struct {
+	majorThird  bool
+	minorThird  bool
+	fifth       string
+	seventh     string
+	extension   int
+	leadingTone bool
+	// Fifths above the root of each chord tone.
+	tones []int
+	// Semitones above the root of the third, fifth and seventh, -1 when missing.
+	inversions [3]int
+}
+
+func analyzeShape(c chord.Chord) shape {
+	s := shape{inversions: [3]int{-1, -1, -1}}
+	for _, name := range c.ChordType.Intervals {
+		interval := pitchinterval.Parse(name)
+		if interval.Empty {
+			continue
+		}
+		s.tones = append(s.tones, interval.Fifths())
+
+		switch interval.Simple {
+		case 3:
+			s.majorThird = s.majorThird || interval.Q == "M"
+			s.minorThird = s.minorThird || interval.Q == "m"
+			s.inversions[0] = interval.Chroma
+		case 5:
+			s.fifth = string(interval.Q)
+			s.inversions[1] = interval.Chroma
+		case 7:
+			s.seventh = string(interval.Q)
+			s.inversions[2] = interval.Chroma
+		}
+		if interval.Num > 7 && interval.Num > s.extension {
+			s.extension = interval.Num
+		}
+	}
+	s.leadingTone = s.minorThird && s.fifth == "d"
+	return s
+}
+
+func (s shape) quality() string {
+	switch {
+	case s.minorThird && s.fifth == "d" && s.seventh == "m":
+		return "ø"
+	case s.minorThird && s.fifth == "d":
+		return "°"
+	case s.majorThird && s.fifth == "A":
+		return "+"
+	case s.seventh == "M":
+		return "M"
+	}
+	return ""
+}
+
+func (s shape) figure(c chord.Chord, root int) string {
+	inversion := 0
+	if bass, ok := chord.NoteFifths(c.Bass); ok {
+		semitones := pitch.Coordinates{Fifths: bass - root}.Chroma()
+		for i, chroma := range s.inversions {
+			if chroma != -1 && chroma == semitones {
+				inversion = i + 1
+			}
+		}
+	}
+
+	if s.seventh == "" {
+		return []string{"", "6", "6/4", ""}[inversion]
+	}
+	if inversion == 0 && s.extension > 7 {
+		return strconv.Itoa(s.extension)
+	}
+	return []string{"7", "6/5", "4/3", "4/2"}[inversion]
+}
+
+func (s shape) isDominant() bool {
+	return s.majorThird && (s.fifth == "" || s.fifth == "P") && (s.seventh == "" || s.seventh == "m")
+}
+
+// Positions on the line of fifths, relative to the tonic, of the notes of the keys.
+// Minor keys include the raised sixth and seventh degrees.
+var (
+	majorNotes = []int{-1, 0, 1, 2, 3, 4, 5}
+	minorNotes = []int{-4, -3, -2, -1, 0, 1, 2, 3, 5}
+)
+
+func isDiatonic(k key.Key, root int, s shape) bool {
+	notes := majorNotes
+	if k.Mode == key.Minor {
+		notes = minorNotes
+	}
+	for _, tone := range s.tones {
+		if !contains(notes, root+tone) {
+			return false
+		}
+	}
+	return true
+}
+
+// secondaryTarget returns the numeral of the degree a chord is the dominant
+// or the leading-tone chord of.
+func secondaryTarget(k key.Key, root int, s shape) (string, bool) {
+	var target int
+	switch {
+	case s.isDominant():
+		target = root - 1 // a fifth below
+	case s.leadingTone:
+		target = root - 5 // a minor second above
+	default:
+		return "", false
+	}
+
+	degree, alt, _ := k.DegreeOf(target)
+	if alt != 0 || degree == 1 {
+		return "", false
+	}
+
+	// The triads of the scale of the key, the diminished ones can't be tonicized.
+	if k.Mode == key.Minor {
+		return []string{"i", "", "III", "iv", "V", "VI", "VII"}[degree-1], degree != 2
+	}
+	return []string{"I", "ii", "iii", "IV", "V", "vi", ""}[degree-1], degree != 7
+}
+
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package romannumeral
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/chord"
+	"github.com/Golevka2001/go-chord-detector/key"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	testCases := []struct {
+		key      string
+		chord    string
+		expected string
+	}{
+		{"C", "C", "I"},
+		{"C", "Dm", "ii"},
+		{"C", "G7", "V7"},
+		{"C", "Bdim", "vii°"},
+		{"C", "Bm7b5", "viiø7"},
+		{"C", "Cmaj7", "IM7"},
+		{"C", "Bb", "bVII"},
+		{"C", "Ab", "bVI"},
+		{"C", "Fm", "iv"},
+		{"C", "Db", "bII"},
+		{"C", "D", "V/V"},
+		{"C", "D7", "V7/V"},
+		{"C", "E7", "V7/vi"},
+		{"C", "A7", "V7/ii"},
+		{"C", "C7", "V7/IV"},
+		{"C", "C#m7b5", "viiø7/ii"},
+		{"C", "F#dim7", "vii°7/V"},
+		{"C", "G7/B", "V6/5"},
+		{"C", "G7/D", "V4/3"},
+		{"C", "G7/F", "V4/2"},
+		{"C", "C/E", "I6"},
+		{"C", "C/G", "I6/4"},
+		{"C", "D7/F#", "V6/5/V"},
+		{"C", "G9", "V9"},
+		{"C", "Caug", "I+"},
+		{"Cm", "Cm", "i"},
+		{"Cm", "Ddim", "ii°"},
+		{"Cm", "Dm7b5", "iiø7"},
+		{"Cm", "G7", "V7"},
+		{"Cm", "Bdim7", "vii°7"},
+		{"Cm", "Bb", "VII"},
+		{"Cm", "Ab", "VI"},
+		{"Cm", "Eb", "III"},
+		{"Cm", "D7", "V7/V"},
+		{"Cm", "Bb7", "VII7"},
+		{"Cm", "C7", "V7/iv"},
+		{"Eb", "F7", "V7/V"},
+		{"A", "D#m7b5", "viiø7/V"},
+	}
+
+	for _, tc := range testCases {
+		numeral := Analyze(key.Get(tc.key), chord.Get(tc.chord))
+		assert.Equal(t, tc.expected, numeral.Name, "%s in %s", tc.chord, tc.key)
+	}
+}
+
+func TestNumeralProperties(t *testing.T) {
+	numeral := Analyze(key.Get("C major"), chord.Get("C#m7b5"))
+	assert.Equal(t, Numeral{
+		Empty:     false,
+		Name:      "viiø7/ii",
+		Degree:    7,
+		Alt:       0,
+		Roman:     "vii",
+		Quality:   "ø",
+		Figure:    "7",
+		Secondary: "ii",
+	}, numeral)
+
+	numeral = Analyze(key.Get("C major"), chord.Get("Bb"))
+	assert.Equal(t, 7, numeral.Degree)
+	assert.Equal(t, -1, numeral.Alt)
+	assert.Equal(t, "", numeral.Secondary)
+}
+
+func TestAnalyzeEmpty(t *testing.T) {
+	assert.Equal(t, NoNumeral, Analyze(key.NoKey, chord.Get("C")))
+	assert.Equal(t, NoNumeral, Analyze(key.Get("C"), chord.Get("Cfoo")))
+	assert.Equal(t, NoNumeral, Analyze(key.Get("C"), chord.NoChord))
+}
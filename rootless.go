@@ -0,0 +1,88 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// RootlessOptions proposes chords whose root is missing from the notes, as in
+// piano voicings where the bass player plays the root: "E G B D" is also a
+// "Cmaj9 (no root)".
+//
+// Rootless candidates are the chord types of the dictionary that have all the
+// notes and one more pitch class, the root (and the optional intervals the Omit
+// policy allows). Their weight starts at 1, times 0.8 for each omitted interval
+// other than the root, and halves when the root is not Bass, the note played by
+// the bass, or not one of the notes of Scale, the key or scale of the context
+// (such as key.Get("Bb major").Scale()), if they are given. The root is spelled
+// like Bass or the notes of Scale when it can be.
+type RootlessOptions struct {
+	Enabled bool
+	Bass    string
+	Scale   []string
+}
+
+// rootlessWeight multiplies the weight of a rootless chord that doesn't fit the
+// bass or the key.
+const rootlessWeight = 0.5
+
+// findRootless returns the rootless chords of the notes, the heaviest first.
+func findRootless(notes []*note.Note, names spelling, options DetectOptions) []FoundChord {
+	found := make([]FoundChord, 0)
+	set := pcset.NotesToSet(notes)
+	if !options.Rootless.Enabled || set.Len() < 3 {
+		return found
+	}
+
+	bass := -1
+	if n, err := pitch.ParseNote(options.Rootless.Bass); err == nil {
+		bass = n.Chroma()
+		names[bass] = n.PitchClass().String()
+	}
+	var scale pcset.Set
+	for _, name := range options.Rootless.Scale {
+		if n, err := pitch.ParseNote(name); err == nil {
+			scale = scale.Union(pcset.SetOf(n.Chroma()))
+			if n.Chroma() != bass && !set.Has(n.Chroma()) {
+				names[n.Chroma()] = n.PitchClass().String()
+			}
+		}
+	}
+
+	for root := 0; root < 12; root++ {
+		if set.Has(root) {
+			continue
+		}
+		mode := set.Rotate(root).Union(pcset.SetOf(0))
+		chordTypes, omissions := matchChordTypes(mode.Chroma(), options)
+		for i, chordType := range chordTypes {
+			chord := FoundChord{
+				Weight:    1.0,
+				Root:      names[root],
+				ChordType: chordType,
+				Quality:   chordType.Quality,
+				Omitted:   append([]string{"1P"}, omissions[i]...),
+				Rootless:  true,
+			}
+			chord.Name = options.chordName(names[root], chordType, chord.Omitted, "")
+			for range omissions[i] {
+				chord.Weight *= omissionWeight
+			}
+			if bass >= 0 && root != bass {
+				chord.Weight *= rootlessWeight
+			}
+			if scale != pcset.EmptySet && !scale.Has(root) {
+				chord.Weight *= rootlessWeight
+			}
+			found = append(found, chord)
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].Weight > found[j].Weight
+	})
+	return found
+}
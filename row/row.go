@@ -0,0 +1,327 @@
+// Twelve-tone rows: row forms, matrix, combinatoriality and verticals.
+package row
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	detector "github.com/Golevka2001/go-chord-detector"
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Row is an ordering of the twelve pitch classes, 0 is C.
+type Row [12]int
+
+var (
+	ErrLength   = errors.New("row: a row has 12 pitch classes")
+	ErrRepeated = errors.New("row: repeated pitch class")
+)
+
+// New returns the row of the pitch classes, reduced to one octave.
+// Returns ErrLength or ErrRepeated if they are not the twelve pitch classes.
+func New(pitchClasses []int) (Row, error) {
+	var r Row
+	if len(pitchClasses) != 12 {
+		return r, ErrLength
+	}
+	var seen pcset.Set
+	for i, pc := range pitchClasses {
+		pc = mod12(pc)
+		if seen.Has(pc) {
+			return r, fmt.Errorf("%w: %d at position %d", ErrRepeated, pc, i+1)
+		}
+		seen = seen.Union(pcset.SetOf(pc))
+		r[i] = pc
+	}
+	return r, nil
+}
+
+// FromNotes returns the row of the pitch classes of the notes.
+func FromNotes(notes []*note.Note) (Row, error) {
+	pitchClasses := make([]int, 0, len(notes))
+	for _, n := range notes {
+		if n == nil || n.Class == note.Nil {
+			return Row{}, ErrLength
+		}
+		pitchClasses = append(pitchClasses, int(n.Class)-1)
+	}
+	return New(pitchClasses)
+}
+
+// Parse reads a row written with note names ("C C# D ...") or with pitch class
+// numbers, T and E for 10 and 11 ("0 1 2 ... T E" or "0123456789TE").
+func Parse(text string) (Row, error) {
+	tokens := strings.Fields(strings.ReplaceAll(text, ",", " "))
+	if len(tokens) == 1 {
+		tokens = strings.Split(tokens[0], "")
+	}
+
+	numbers := true
+	for _, token := range tokens {
+		if _, ok := parseNumber(token); !ok {
+			numbers = false
+		}
+	}
+
+	pitchClasses := make([]int, 0, len(tokens))
+	for _, token := range tokens {
+		pc, ok := parseNumber(token)
+		if !numbers {
+			class := note.ClassNamed(token)
+			pc, ok = int(class)-1, class != note.Nil
+		}
+		if !ok {
+			return Row{}, fmt.Errorf("row: invalid pitch class %q", token)
+		}
+		pitchClasses = append(pitchClasses, pc)
+	}
+	return New(pitchClasses)
+}
+
+func parseNumber(token string) (int, bool) {
+	switch token {
+	case "T", "t":
+		return 10, true
+	case "E", "e":
+		return 11, true
+	}
+	number, err := strconv.Atoi(token)
+	return number, err == nil && number >= 0 && number < 12
+}
+
+// Kind is the kind of a row form.
+type Kind string
+
+const (
+	Prime             Kind = "P"
+	Retrograde        Kind = "R"
+	Inversion         Kind = "I"
+	RetrogradeInverse Kind = "RI"
+)
+
+// Form is a transformation of a row. Forms are labelled with the pitch class
+// they start with for P and I, and end with for R and RI: R5 is P5 backwards.
+type Form struct {
+	Kind Kind
+	N    int
+}
+
+// ParseForm reads a form label: "P0", "R11", "I4", "RI7".
+func ParseForm(label string) (Form, bool) {
+	for _, kind := range []Kind{RetrogradeInverse, Retrograde, Inversion, Prime} {
+		if strings.HasPrefix(label, string(kind)) {
+			n, err := strconv.Atoi(label[len(kind):])
+			if err != nil || n < 0 || n > 11 {
+				return Form{}, false
+			}
+			return Form{Kind: kind, N: n}, true
+		}
+	}
+	return Form{}, false
+}
+
+func (f Form) String() string {
+	return string(f.Kind) + strconv.Itoa(f.N)
+}
+
+// Forms returns the 48 forms of a row: P0 to P11, then R, I and RI.
+func Forms() []Form {
+	forms := make([]Form, 0, 48)
+	for _, kind := range []Kind{Prime, Retrograde, Inversion, RetrogradeInverse} {
+		for n := 0; n < 12; n++ {
+			forms = append(forms, Form{Kind: kind, N: n})
+		}
+	}
+	return forms
+}
+
+// Form returns a form of the row.
+func (r Row) Form(form Form) Row {
+	var result Row
+	for i, pc := range r {
+		switch form.Kind {
+		case Prime, Retrograde:
+			result[i] = mod12(pc - r[0] + form.N)
+		case Inversion, RetrogradeInverse:
+			result[i] = mod12(r[0] - pc + form.N)
+		}
+	}
+	if form.Kind == Retrograde || form.Kind == RetrogradeInverse {
+		for i, j := 0, 11; i < j; i, j = i+1, j-1 {
+			result[i], result[j] = result[j], result[i]
+		}
+	}
+	return result
+}
+
+// P, R, I and RI return the forms of the row.
+func (r Row) P(n int) Row  { return r.Form(Form{Kind: Prime, N: n}) }
+func (r Row) R(n int) Row  { return r.Form(Form{Kind: Retrograde, N: n}) }
+func (r Row) I(n int) Row  { return r.Form(Form{Kind: Inversion, N: n}) }
+func (r Row) RI(n int) Row { return r.Form(Form{Kind: RetrogradeInverse, N: n}) }
+
+// Matrix is the twelve-tone matrix of a row: each line is a prime form, read
+// backwards a retrograde; each column is an inversion, read upwards a
+// retrograde inversion. The first line is the row transposed to start on the
+// first pitch class of the row.
+type Matrix [12][12]int
+
+// Matrix returns the matrix of the row.
+func (r Row) Matrix() Matrix {
+	var m Matrix
+	for line, start := range r.I(r[0]) {
+		m[line] = r.P(start)
+	}
+	return m
+}
+
+// String writes the matrix with note names, one line per prime form.
+func (m Matrix) String() string {
+	lines := make([]string, 12)
+	for i, line := range m {
+		lines[i] = Row(line).String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String writes the row with note names: "C C# D ...".
+func (r Row) String() string {
+	names := make([]string, 12)
+	for i, pc := range r {
+		names[i] = note.Class(pc + 1).String(note.Sharp)
+	}
+	return strings.Join(names, " ")
+}
+
+// Notes returns a note without octave for each pitch class of the row.
+func (r Row) Notes() []*note.Note {
+	notes := make([]*note.Note, 12)
+	for i, pc := range r {
+		notes[i] = &note.Note{Class: note.Class(pc + 1)}
+	}
+	return notes
+}
+
+// Segment returns the set of the pitch classes from start (included) to end (excluded).
+// Indices are clamped to the row: Segment(3, 20) is Segment(3, 12), and the
+// segment is empty when end is not after start.
+func (r Row) Segment(start int, end int) pcset.Set {
+	start, end = clamp(start, 0, len(r)), clamp(end, 0, len(r))
+	if start >= end {
+		return pcset.EmptySet
+	}
+	return pcset.SetOf(r[start:end]...)
+}
+
+func clamp(n int, low int, high int) int {
+	if n < low {
+		return low
+	}
+	if n > high {
+		return high
+	}
+	return n
+}
+
+// Hexachords returns the sets of the first and the second half of the row.
+func (r Row) Hexachords() [2]pcset.Set {
+	return [2]pcset.Set{r.Segment(0, 6), r.Segment(6, 12)}
+}
+
+// Occurrence is a row form found in a sequence of notes, from the note at Start.
+type Occurrence struct {
+	Form  Form
+	Start int
+}
+
+// Find returns the forms of the row that are played, as twelve consecutive
+// notes, in a sequence. Overlapping occurrences are all returned, by position.
+func (r Row) Find(notes []*note.Note) []Occurrence {
+	result := make([]Occurrence, 0)
+	for start := 0; start+12 <= len(notes); start++ {
+		candidate, err := FromNotes(notes[start : start+12])
+		if err != nil {
+			continue
+		}
+		for _, form := range Forms() {
+			if r.Form(form) == candidate {
+				result = append(result, Occurrence{Form: form, Start: start})
+			}
+		}
+	}
+	return result
+}
+
+// CombinatorialForms returns the forms whose first hexachord is the complement
+// of the first hexachord of P0 (the row transposed to C), so that the two forms
+// played together complete the aggregate in each half. Every row combines with
+// its retrograde R0.
+func (r Row) CombinatorialForms() []Form {
+	first := r.P(0).Hexachords()[0]
+	result := make([]Form, 0)
+	for _, form := range Forms() {
+		if r.Form(form).Hexachords()[0] == first.Complement() {
+			result = append(result, form)
+		}
+	}
+	return result
+}
+
+// IsCombinatorial returns true if the row is hexachordally combinatorial with a
+// form of the given kind.
+func (r Row) IsCombinatorial(kind Kind) bool {
+	for _, form := range r.CombinatorialForms() {
+		if form.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllCombinatorial returns true if the row is combinatorial with forms of the
+// four kinds, like the rows whose first hexachord is 012345.
+func (r Row) IsAllCombinatorial() bool {
+	for _, kind := range []Kind{Prime, Retrograde, Inversion, RetrogradeInverse} {
+		if !r.IsCombinatorial(kind) {
+			return false
+		}
+	}
+	return true
+}
+
+// Vertical is a segment of consecutive pitch classes of the row played as a
+// chord, with the lowest note first. Chords are the names detector.Detect gives.
+type Vertical struct {
+	Start  int
+	Set    pcset.Set
+	Chords []string
+}
+
+// Verticals returns the chords formed by every segment of size consecutive
+// pitch classes of the row, from the first one.
+func (r Row) Verticals(size int) []Vertical {
+	result := make([]Vertical, 0)
+	if size < 1 || size > 12 {
+		return result
+	}
+	notes := r.Notes()
+	for start := 0; start+size <= 12; start++ {
+		chords := detector.Detect(notes[start : start+size])
+		if chords == nil {
+			chords = []string{}
+		}
+		result = append(result, Vertical{
+			Start:  start,
+			Set:    r.Segment(start, start+size),
+			Chords: chords,
+		})
+	}
+	return result
+}
+
+func mod12(n int) int {
+	return ((n % 12) + 12) % 12
+}
@@ -0,0 +1,120 @@
+package row
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+// Berg, Violin Concerto.
+var berg = Row{7, 10, 2, 6, 9, 0, 4, 8, 11, 1, 3, 5}
+
+var chromatic = Row{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+
+func TestNew(t *testing.T) {
+	r, err := New([]int{7, 10, 2, 6, 9, 12, 4, 8, 11, 1, 3, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, berg, r)
+
+	_, err = New([]int{0, 1, 2})
+	assert.Equal(t, ErrLength, err)
+	_, err = New([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 0})
+	assert.True(t, errors.Is(err, ErrRepeated))
+	assert.EqualError(t, err, "row: repeated pitch class: 0 at position 12")
+}
+
+func TestParse(t *testing.T) {
+	for _, text := range []string{
+		"G A# D F# A C E G# B C# D# F",
+		"G, Bb, D, F#, A, C, E, G#, B, C#, D#, F",
+		"7 T 2 6 9 0 4 8 E 1 3 5",
+		"7T269048E135",
+	} {
+		r, err := Parse(text)
+		assert.NoError(t, err, text)
+		assert.Equal(t, berg, r, text)
+	}
+
+	_, err := Parse("C D E")
+	assert.Equal(t, ErrLength, err)
+	_, err = Parse("C D E F G A B H C# D# F# G#")
+	assert.EqualError(t, err, `row: invalid pitch class "H"`)
+}
+
+func TestForms(t *testing.T) {
+	assert.Len(t, Forms(), 48)
+	assert.Equal(t, Row{0, 3, 7, 11, 2, 5, 9, 1, 4, 6, 8, 10}, berg.P(0))
+	assert.Equal(t, berg, berg.P(7))
+	assert.Equal(t, Row{5, 3, 1, 11, 8, 4, 0, 9, 6, 2, 10, 7}, berg.R(7))
+	assert.Equal(t, Row{7, 4, 0, 8, 5, 2, 10, 6, 3, 1, 11, 9}, berg.I(7))
+	assert.Equal(t, Row{9, 11, 1, 3, 6, 10, 2, 5, 8, 0, 4, 7}, berg.RI(7))
+
+	form, ok := ParseForm("RI7")
+	assert.True(t, ok)
+	assert.Equal(t, Form{Kind: RetrogradeInverse, N: 7}, form)
+	assert.Equal(t, "RI7", form.String())
+	_, ok = ParseForm("P12")
+	assert.False(t, ok)
+	_, ok = ParseForm("X1")
+	assert.False(t, ok)
+}
+
+func TestMatrix(t *testing.T) {
+	m := berg.Matrix()
+	assert.Equal(t, [12]int(berg), m[0])
+	for i := 0; i < 12; i++ {
+		column := Row{}
+		for j := 0; j < 12; j++ {
+			column[j] = m[j][i]
+		}
+		assert.Equal(t, berg.I(berg[i]), column, "Columns are inversions")
+		assert.Equal(t, 7, m[i][i], "The diagonal is the first pitch class")
+	}
+	assert.Equal(t, "G A# D F# A C E G# B C# D# F", Row(m[0]).String())
+}
+
+func TestFind(t *testing.T) {
+	notes := []*note.Note{{Class: note.C}}
+	notes = append(notes, berg.I(2).Notes()...)
+	notes = append(notes, berg.RI(7).Notes()...)
+
+	occurrences := berg.Find(notes)
+	assert.Contains(t, occurrences, Occurrence{Form: Form{Kind: Inversion, N: 2}, Start: 1})
+	assert.Contains(t, occurrences, Occurrence{Form: Form{Kind: RetrogradeInverse, N: 7}, Start: 13})
+	assert.Empty(t, berg.Find(notes[:12]))
+}
+
+func TestSegment(t *testing.T) {
+	assert.Equal(t, pcset.SetOf(7, 10, 2), berg.Segment(0, 3))
+	assert.Equal(t, berg.Segment(9, 12), berg.Segment(9, 20))
+	assert.Equal(t, berg.Segment(0, 2), berg.Segment(-3, 2))
+	assert.Equal(t, pcset.EmptySet, berg.Segment(5, 5))
+	assert.Equal(t, pcset.EmptySet, berg.Segment(8, 3))
+}
+
+func TestCombinatoriality(t *testing.T) {
+	assert.True(t, chromatic.IsAllCombinatorial())
+	assert.Contains(t, chromatic.CombinatorialForms(), Form{Kind: Prime, N: 6})
+	assert.Contains(t, chromatic.CombinatorialForms(), Form{Kind: Inversion, N: 11})
+
+	// Schoenberg, Piano Piece op. 33a: inversionally combinatorial.
+	schoenberg, _ := Parse("Bb F C B A F# C# Eb G Ab D E")
+	assert.True(t, schoenberg.IsCombinatorial(Inversion))
+	assert.False(t, schoenberg.IsCombinatorial(Prime))
+	assert.False(t, schoenberg.IsAllCombinatorial())
+	assert.False(t, berg.IsAllCombinatorial())
+}
+
+func TestVerticals(t *testing.T) {
+	verticals := berg.Verticals(3)
+	assert.Len(t, verticals, 10)
+	assert.Equal(t, pcset.SetOf(7, 10, 2), verticals[0].Set)
+	assert.Contains(t, verticals[0].Chords, "Gm")
+	assert.Contains(t, verticals[1].Chords, "A#aug")
+	assert.Contains(t, verticals[2].Chords, "DM")
+	assert.Contains(t, berg.Verticals(4)[0].Chords, "Gm/ma7")
+	assert.Empty(t, berg.Verticals(13))
+}
@@ -0,0 +1,119 @@
+// Detect the scales that contain a set of notes.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/scale/index.ts
+package scale
+
+import (
+	"sort"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/Golevka2001/go-chord-detector/scaletype"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Match is a scale that contains the detected notes.
+//
+// Name is the tonic followed by the name of the scale type, e.g. "C major".
+// Exact is true when the scale has exactly the detected notes, false when it has
+// more notes (Extra of them).
+type Match struct {
+	Name      string
+	Tonic     note.Class
+	ScaleType scaletype.ScaleType
+	Exact     bool
+	Extra     int
+}
+
+// DetectOptions changes the matches returned by DetectWithOptions.
+//
+// Tonic restricts the matches to scales starting on the given note. When it is
+// note.Nil, every detected note is tried as the tonic, the first note first.
+// ExactOnly discards the scales that have more notes than the detected ones.
+type DetectOptions struct {
+	Tonic     note.Class
+	ExactOnly bool
+}
+
+func Detect(notes []*note.Note) []Match {
+	return DetectWithOptions(notes, DetectOptions{})
+}
+
+// DetectWithOptions returns the scales that contain the notes: the exact matches
+// first, then the scales with the fewest extra notes. Matches with the same
+// number of notes are ordered by tonic, in the order of the notes.
+func DetectWithOptions(notes []*note.Note, options DetectOptions) []Match {
+	return detect(notes, func(tonic note.Class) string { return tonic.String(note.Sharp) }, options)
+}
+
+// DetectPitches is like Detect for spelled notes: the names of the scales keep
+// the spelling of the notes, "Eb F G Ab Bb C D" is "Eb major" and not "D# major".
+func DetectPitches(notes []pitch.Note) []Match {
+	return DetectPitchesWithOptions(notes, DetectOptions{})
+}
+
+func DetectPitchesWithOptions(notes []pitch.Note, options DetectOptions) []Match {
+	names := make(map[note.Class]string)
+	for _, n := range notes {
+		class := n.ToNote().Class
+		if _, exists := names[class]; !exists {
+			names[class] = n.PitchClass().String()
+		}
+	}
+	return detect(pitch.ToNotes(notes), func(tonic note.Class) string {
+		if name, exists := names[tonic]; exists {
+			return name
+		}
+		return tonic.String(note.Sharp)
+	}, options)
+}
+
+func detect(notes []*note.Note, name func(tonic note.Class) string, options DetectOptions) []Match {
+	result := make([]Match, 0)
+	set := pcset.NotesToSet(notes)
+	if set == pcset.EmptySet {
+		return result
+	}
+
+	for _, tonic := range tonics(notes, options.Tonic) {
+		// The notes as intervals above the tonic.
+		intervals := set.Rotate(int(tonic) - 1)
+		for _, scaleType := range scaletype.All() {
+			if !intervals.IsSubsetOf(scaleType.Set()) {
+				continue
+			}
+			extra := scaleType.Set().Len() - intervals.Len()
+			if options.ExactOnly && extra > 0 {
+				continue
+			}
+			result = append(result, Match{
+				Name:      name(tonic) + " " + scaleType.Name,
+				Tonic:     tonic,
+				ScaleType: scaleType,
+				Exact:     extra == 0,
+				Extra:     extra,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Extra < result[j].Extra
+	})
+	return result
+}
+
+// tonics returns the pitch classes to try as the tonic, without repetition.
+func tonics(notes []*note.Note, tonic note.Class) []note.Class {
+	if tonic != note.Nil {
+		return []note.Class{tonic}
+	}
+
+	result := make([]note.Class, 0)
+	seen := make(map[note.Class]bool)
+	for _, n := range notes {
+		if n.Class != note.Nil && !seen[n.Class] {
+			seen[n.Class] = true
+			result = append(result, n.Class)
+		}
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/scale/test.ts
+package scale
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pitch"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNotes(noteNames []string) []*note.Note {
+	var notes []*note.Note
+	for _, name := range noteNames {
+		noteClass := note.ClassNamed(name)
+		if noteClass != note.Nil {
+			notes = append(notes, &note.Note{Class: noteClass})
+		}
+	}
+	return notes
+}
+
+func names(matches []Match) []string {
+	result := make([]string, len(matches))
+	for i, match := range matches {
+		result[i] = match.Name
+	}
+	return result
+}
+
+func TestDetect(t *testing.T) {
+	matches := Detect(createNotes([]string{"D", "E", "F", "G", "A", "B", "C"}))
+	assert.Equal(t, "D dorian", matches[0].Name, "The first note is tried first")
+	assert.True(t, matches[0].Exact)
+	assert.Contains(t, names(matches), "C major")
+	assert.Contains(t, names(matches), "A minor")
+	assert.Contains(t, names(matches), "D bebop minor", "Supersets are returned too")
+
+	for i, match := range matches {
+		if !match.Exact {
+			assert.Equal(t, 7, i, "Exact matches come first, one for each mode")
+			break
+		}
+	}
+}
+
+func TestDetectSupersets(t *testing.T) {
+	matches := DetectWithOptions(createNotes([]string{"C", "E", "G"}), DetectOptions{Tonic: note.C})
+	assert.Equal(t, "C major pentatonic", matches[0].Name)
+	assert.Equal(t, 2, matches[0].Extra)
+	assert.False(t, matches[0].Exact)
+	assert.Contains(t, names(matches), "C major")
+	assert.Contains(t, names(matches), "C lydian")
+	assert.NotContains(t, names(matches), "C minor")
+	for i := 1; i < len(matches); i++ {
+		assert.LessOrEqual(t, matches[i-1].Extra, matches[i].Extra, "Matches are ordered by extra notes")
+	}
+}
+
+func TestDetectExactOnly(t *testing.T) {
+	notes := createNotes([]string{"C", "D", "E", "G", "A"})
+	matches := DetectWithOptions(notes, DetectOptions{ExactOnly: true})
+	assert.Equal(t, []string{"C major pentatonic", "D egyptian", "G ritusen", "A minor pentatonic"}, names(matches))
+}
+
+func TestDetectPitches(t *testing.T) {
+	var notes []pitch.Note
+	for _, name := range []string{"Eb", "F", "G", "Ab", "Bb", "C", "D"} {
+		notes = append(notes, pitch.MustParseNote(name))
+	}
+	matches := DetectPitchesWithOptions(notes, DetectOptions{ExactOnly: true})
+	assert.Contains(t, names(matches), "Eb major")
+	assert.Contains(t, names(matches), "C minor")
+	assert.Equal(t, note.Ds, matches[0].Tonic)
+}
@@ -0,0 +1,70 @@
+// Scale list.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/scale-type/data.ts
+package scaletype
+
+// Format: ["intervals", "full name", "alias1", "alias2", ...].
+var scales = [][]string{
+	// ==Diatonic modes==
+	{"1P 2M 3M 4P 5P 6M 7M", "major", "ionian"},
+	{"1P 2M 3m 4P 5P 6M 7m", "dorian"},
+	{"1P 2m 3m 4P 5P 6m 7m", "phrygian"},
+	{"1P 2M 3M 4A 5P 6M 7M", "lydian"},
+	{"1P 2M 3M 4P 5P 6M 7m", "mixolydian", "dominant"},
+	{"1P 2M 3m 4P 5P 6m 7m", "minor", "aeolian", "natural minor"},
+	{"1P 2m 3m 4P 5d 6m 7m", "locrian"},
+	// ==Harmonic minor modes==
+	{"1P 2M 3m 4P 5P 6m 7M", "harmonic minor"},
+	{"1P 2m 3m 4P 5d 6M 7m", "locrian 6", "locrian natural 6", "locrian #6"},
+	{"1P 2M 3M 4P 5A 6M 7M", "ionian augmented", "ionian #5"},
+	{"1P 2M 3m 4A 5P 6M 7m", "dorian #4", "ukrainian dorian", "romanian minor"},
+	{"1P 2m 3M 4P 5P 6m 7m", "phrygian dominant", "spanish", "phrygian major"},
+	{"1P 2A 3M 4A 5P 6M 7M", "lydian #9"},
+	{"1P 2m 3m 4d 5d 6m 7d", "ultralocrian", "superlocrian bb7"},
+	// ==Melodic minor modes==
+	{"1P 2M 3m 4P 5P 6M 7M", "melodic minor", "jazz minor"},
+	{"1P 2m 3m 4P 5P 6M 7m", "dorian b2", "phrygian #6"},
+	{"1P 2M 3M 4A 5A 6M 7M", "lydian augmented", "lydian #5"},
+	{"1P 2M 3M 4A 5P 6M 7m", "lydian dominant", "lydian b7", "overtone"},
+	{"1P 2M 3M 4P 5P 6m 7m", "mixolydian b6", "aeolian dominant", "hindu"},
+	{"1P 2M 3m 4P 5d 6m 7m", "locrian #2", "half-diminished", "aeolian b5"},
+	{"1P 2m 2A 3M 5d 6m 7m", "altered", "super locrian", "diminished whole tone"},
+	// ==Other heptatonic scales==
+	{"1P 2M 3M 4P 5P 6m 7M", "harmonic major"},
+	{"1P 2m 3M 4P 5P 6m 7M", "double harmonic major", "gypsy", "byzantine"},
+	{"1P 2M 3m 4A 5P 6m 7M", "hungarian minor"},
+	{"1P 2m 3m 4P 5P 6M 7M", "neapolitan major"},
+	{"1P 2m 3m 4P 5P 6m 7M", "neapolitan minor"},
+	{"1P 2M 3M 4P 5d 6m 7m", "locrian major", "arabian"},
+	// ==Symmetric scales==
+	{"1P 2M 3M 4A 5A 7m", "whole tone", "messiaen's mode #1"},
+	{"1P 2M 3m 4P 5d 6m 6M 7M", "diminished", "whole-half diminished"},
+	{"1P 2m 2A 3M 4A 5P 6M 7m", "dominant diminished", "half-whole diminished", "messiaen's mode #2"},
+	{"1P 2A 3M 5P 5A 7M", "augmented"},
+	{"1P 2m 3M 4P 5A 6M", "six tone symmetric"},
+	{"1P 2M 3m 3M 4A 5P 6m 7m 7M", "messiaen's mode #3"},
+	{"1P 2m 2M 4P 5d 5P 6m 7M", "messiaen's mode #4"},
+	{"1P 2m 2M 3m 3M 4P 5d 5P 6m 6M 7m 7M", "chromatic"},
+	// ==Pentatonic scales==
+	{"1P 2M 3M 5P 6M", "major pentatonic", "pentatonic"},
+	{"1P 3m 4P 5P 7m", "minor pentatonic"},
+	{"1P 2M 4P 5P 7m", "egyptian", "suspended pentatonic"},
+	{"1P 2M 4P 5P 6M", "ritusen"},
+	{"1P 3m 4P 5P 6M", "minor six pentatonic"},
+	{"1P 2M 3M 5P 7m", "dominant pentatonic"},
+	{"1P 2M 3m 5P 6M", "kumoi", "flat three pentatonic"},
+	{"1P 2M 3m 5P 6m", "hirajoshi"},
+	{"1P 2m 4P 5P 7m", "in-sen"},
+	{"1P 2m 4P 5d 7m", "iwato"},
+	{"1P 2m 3m 5P 6m", "pelog"},
+	{"1P 3M 4A 5P 7M", "lydian pentatonic", "chinese"},
+	{"1P 3m 4P 5d 7m", "locrian pentatonic"},
+	// ==Blues scales==
+	{"1P 3m 4P 5d 5P 7m", "minor blues", "blues"},
+	{"1P 2M 3m 3M 5P 6M", "major blues"},
+	// ==Bebop scales==
+	{"1P 2M 3M 4P 5P 6M 7m 7M", "bebop", "bebop dominant"},
+	{"1P 2M 3M 4P 5P 6m 6M 7M", "bebop major"},
+	{"1P 2M 3m 3M 4P 5P 6M 7m", "bebop minor", "bebop dorian"},
+	{"1P 2M 3m 4P 5P 6m 6M 7M", "bebop melodic minor"},
+	{"1P 2m 3m 4P 5d 5P 6m 7m", "bebop locrian"},
+}
@@ -0,0 +1,119 @@
+// A dictionary of musical scales.
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/scale-type/index.ts
+package scaletype
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+)
+
+type ScaleType struct {
+	pcset.Pcset
+	Name      string
+	Aliases   []string
+	Intervals []string
+}
+
+var NoScaleType = ScaleType{
+	Pcset:     pcset.EmptyPcset,
+	Name:      "",
+	Intervals: []string{},
+	Aliases:   []string{},
+}
+
+var dictionary []ScaleType
+var index map[string]ScaleType
+
+func init() {
+	dictionary = make([]ScaleType, 0)
+	index = make(map[string]ScaleType)
+
+	for _, data := range scales {
+		if len(data) >= 2 {
+			intervals := strings.Split(data[0], " ")
+			fullName := data[1]
+			aliases := append([]string{}, data[2:]...)
+			if _, err := Add(intervals, fullName, aliases); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	sort.SliceStable(dictionary, func(i, j int) bool {
+		return len(dictionary[i].Intervals) < len(dictionary[j].Intervals)
+	})
+}
+
+// Get retrieves a scale type by name, alias, chroma, or setNum.
+func Get(typeName string) ScaleType {
+	if scale, exists := index[typeName]; exists {
+		return scale
+	}
+	return NoScaleType
+}
+
+// Names returns all scale names.
+func Names() []string {
+	var names []string
+	for _, scale := range dictionary {
+		names = append(names, scale.Name)
+	}
+	return names
+}
+
+// Keys returns all the keys used to reference scale types
+func Keys() []string {
+	var keys []string
+	for key := range index {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// All return a list of all scale types.
+func All() []ScaleType {
+	return dictionary
+}
+
+// RemoveAll clears the dictionary and index.
+func RemoveAll() {
+	dictionary = make([]ScaleType, 0)
+	index = make(map[string]ScaleType)
+}
+
+// Add adds a scale to the dictionary.
+// Returns an error, and adds nothing, if one of the intervals is invalid.
+func Add(intervals []string, fullName string, aliases []string) (ScaleType, error) {
+	set, err := pcset.IntervalsToPcset(intervals)
+	if err != nil {
+		return NoScaleType, fmt.Errorf("scaletype: %q: %w", fullName, err)
+	}
+
+	scale := ScaleType{
+		Pcset:     set,
+		Name:      fullName,
+		Intervals: intervals,
+		Aliases:   aliases,
+	}
+	if aliases == nil {
+		scale.Aliases = []string{}
+	}
+
+	dictionary = append(dictionary, scale)
+	index[scale.Name] = scale
+	index[strconv.Itoa(scale.Pcset.SetNum)] = scale
+	index[scale.Pcset.Chroma] = scale
+
+	for _, alias := range scale.Aliases {
+		AddAlias(scale, alias)
+	}
+	return scale, nil
+}
+
+func AddAlias(scale ScaleType, alias string) {
+	index[alias] = scale
+}
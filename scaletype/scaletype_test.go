@@ -0,0 +1,71 @@
+// Reference: https://github.com/tonaljs/tonal/tree/main/packages/scale-type/test.ts
+package scaletype
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	major := Get("major")
+	assert.Equal(t, "major", major.Name)
+	assert.Equal(t, "101011010101", major.Chroma)
+	assert.Equal(t, []string{"1P", "2M", "3M", "4P", "5P", "6M", "7M"}, major.Intervals)
+	assert.Equal(t, []string{"ionian"}, major.Aliases)
+
+	assert.Equal(t, major, Get("ionian"), "Should get a scale by alias")
+	assert.Equal(t, Get("minor"), Get("natural minor"), "Aliases can have several words")
+	assert.Equal(t, "major pentatonic", Get("101010010100").Name, "Should get a scale by chroma")
+	assert.Equal(t, "major", Get("2773").Name, "Should get a scale by set number")
+	assert.True(t, Get("unknown").Empty)
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+	assert.Len(t, all, len(scales))
+	assert.Equal(t, "major pentatonic", all[0].Name, "Scales are sorted by number of notes")
+	assert.Equal(t, "chromatic", all[len(all)-1].Name)
+
+	chromas := make(map[string]string)
+	for _, scale := range all {
+		if other, exists := chromas[scale.Chroma]; exists {
+			t.Errorf("%q and %q have the same chroma", other, scale.Name)
+		}
+		chromas[scale.Chroma] = scale.Name
+		assert.Len(t, scale.Intervals, len(scale.Pcset.Intervals), "%q has repeated pitch classes", scale.Name)
+	}
+}
+
+func TestCategories(t *testing.T) {
+	for _, name := range []string{
+		"dorian", "phrygian", "lydian", "mixolydian", "aeolian", "locrian",
+		"harmonic minor", "phrygian dominant", "melodic minor", "altered", "lydian dominant",
+		"whole tone", "diminished", "augmented", "minor pentatonic", "blues", "bebop", "bebop major",
+	} {
+		assert.False(t, Get(name).Empty, "Should have %q", name)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	quinta, err := Add([]string{"1P", "5P"}, "quinta", []string{"q"})
+	assert.NoError(t, err)
+	assert.Equal(t, quinta, Get("q"))
+	assert.Equal(t, "100000010000", Get("q").Chroma)
+	assert.Equal(t, Get("quinta"), Get("q"))
+	assert.Contains(t, Names(), "quinta")
+}
+
+func TestAddInvalid(t *testing.T) {
+	scale, err := Add([]string{"1P", "2M", "3X"}, "bad scale", nil)
+	assert.ErrorIs(t, err, pitchinterval.ErrQuality)
+	assert.True(t, scale.Empty)
+	assert.True(t, Get("bad scale").Empty)
+}
+
+func TestRemoveAll(t *testing.T) {
+	RemoveAll()
+	assert.Empty(t, All(), "Should have no scales after RemoveAll")
+	assert.Empty(t, Keys(), "Should have no keys after RemoveAll")
+}
@@ -0,0 +1,30 @@
+// Set classes of three to six pitch classes, in the order of Forte's numbers.
+// Reference: Allen Forte, The Structure of Atonal Music (1973), appendix 1.
+package setclass
+
+// Format: prime forms (Forte's algorithm), with T for 10 and E for 11.
+// The set classes of seven to nine pitch classes are the complements of the
+// ones of five to three, with the same numbers.
+var primeForms = map[int][]string{
+	3: {
+		"012", "013", "014", "015", "016", "024", "025", "026", "027", "036", "037", "048",
+	},
+	4: {
+		"0123", "0124", "0134", "0125", "0126", "0127", "0145", "0156", "0167", "0235",
+		"0135", "0236", "0136", "0237", "0146", "0157", "0347", "0147", "0148", "0158",
+		"0246", "0247", "0257", "0248", "0268", "0358", "0258", "0369", "0137",
+	},
+	5: {
+		"01234", "01235", "01245", "01236", "01237", "01256", "01267", "02346", "01246", "01346",
+		"02347", "01356", "01248", "01257", "01268", "01347", "01348", "01457", "01367", "01378",
+		"01458", "01478", "02357", "01357", "02358", "02458", "01358", "02368", "01368", "01468",
+		"01369", "01469", "02468", "02469", "02479", "01247", "03458", "01258",
+	},
+	6: {
+		"012345", "012346", "012356", "012456", "012367", "012567", "012678", "023457", "012357", "013457",
+		"012457", "012467", "013467", "013458", "012458", "014568", "012478", "012578", "013478", "014589",
+		"023468", "012468", "023568", "013468", "013568", "013578", "013469", "013569", "013689", "013679",
+		"013589", "024579", "023579", "013579", "02468T", "012347", "012348", "012378", "023458", "012358",
+		"012368", "012369", "012568", "012569", "023469", "012469", "012479", "012579", "013479", "014679",
+	},
+}
@@ -0,0 +1,170 @@
+// A catalogue of the set classes: the pitch class sets that are equivalent by
+// transposition and inversion, named after Forte's numbers ("4-Z15").
+package setclass
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+)
+
+// SetClass is a set class.
+//
+// Name is the Forte number, with a "Z" for the classes that share their interval
+// vector with another class ("4-Z15"). Cardinalities 0, 1, 2, 10, 11 and 12,
+// which Forte didn't number, are numbered the same way ("2-6" is the tritone).
+//
+// Prime is the prime form with Rahn's algorithm and FortePrime with Forte's,
+// both starting on C.
+type SetClass struct {
+	Empty          bool
+	Name           string
+	Cardinality    int
+	Number         int
+	Z              bool
+	Prime          pcset.Set
+	FortePrime     pcset.Set
+	IntervalVector [6]int
+}
+
+var NoSetClass = SetClass{
+	Empty:          true,
+	Name:           "",
+	Cardinality:    0,
+	Number:         0,
+	Z:              false,
+	Prime:          pcset.EmptySet,
+	FortePrime:     pcset.EmptySet,
+	IntervalVector: [6]int{},
+}
+
+var catalogue []SetClass
+var byName map[string]SetClass
+var byPrime map[pcset.Set]SetClass
+
+func init() {
+	catalogue = make([]SetClass, 0, 224)
+	byName = make(map[string]SetClass)
+	byPrime = make(map[pcset.Set]SetClass)
+
+	sets := make(map[int][]pcset.Set)
+	for cardinality, forms := range primeForms {
+		for _, form := range forms {
+			sets[cardinality] = append(sets[cardinality], parsePrimeForm(form))
+		}
+	}
+	// Dyads, by interval class.
+	for interval := 1; interval <= 6; interval++ {
+		sets[2] = append(sets[2], pcset.SetOf(0, interval))
+	}
+	sets[0] = []pcset.Set{pcset.EmptySet}
+	sets[1] = []pcset.Set{pcset.SetOf(0)}
+	for cardinality := 7; cardinality <= 12; cardinality++ {
+		for _, set := range sets[12-cardinality] {
+			sets[cardinality] = append(sets[cardinality], set.Complement())
+		}
+	}
+
+	for cardinality := 0; cardinality <= 12; cardinality++ {
+		for i, set := range sets[cardinality] {
+			add(cardinality, i+1, set, sets[cardinality])
+		}
+	}
+}
+
+func add(cardinality int, number int, set pcset.Set, sameCardinality []pcset.Set) {
+	setClass := SetClass{
+		Empty:          false,
+		Cardinality:    cardinality,
+		Number:         number,
+		Prime:          set.PrimeForm(),
+		FortePrime:     set.PrimeFormForte(),
+		IntervalVector: set.IntervalVector(),
+	}
+	for _, other := range sameCardinality {
+		if set.IsZRelated(other) {
+			setClass.Z = true
+		}
+	}
+
+	z := ""
+	if setClass.Z {
+		z = "Z"
+	}
+	setClass.Name = strconv.Itoa(cardinality) + "-" + z + strconv.Itoa(number)
+
+	catalogue = append(catalogue, setClass)
+	byName[setClass.Name] = setClass
+	byName[strconv.Itoa(cardinality)+"-"+strconv.Itoa(number)] = setClass
+	byPrime[setClass.Prime] = setClass
+}
+
+// Get returns a set class by its Forte number, with or without the "Z": "4-Z15" or "4-15".
+func Get(name string) SetClass {
+	if setClass, exists := byName[strings.TrimSpace(name)]; exists {
+		return setClass
+	}
+	return NoSetClass
+}
+
+// Of returns the set class of a pitch class set.
+func Of(set pcset.Set) SetClass {
+	if setClass, exists := byPrime[set.PrimeForm()]; exists {
+		return setClass
+	}
+	return NoSetClass
+}
+
+// All returns the 224 set classes, by cardinality and Forte number.
+func All() []SetClass {
+	return catalogue
+}
+
+// ZPartners returns the other set classes with the same interval vector.
+func (c SetClass) ZPartners() []SetClass {
+	partners := make([]SetClass, 0)
+	if !c.Z {
+		return partners
+	}
+	for _, other := range catalogue {
+		if other.Cardinality == c.Cardinality && other.Number != c.Number && other.IntervalVector == c.IntervalVector {
+			partners = append(partners, other)
+		}
+	}
+	return partners
+}
+
+// Complement returns the set class of the complements of the sets of the class.
+func (c SetClass) Complement() SetClass {
+	if c.Empty {
+		return NoSetClass
+	}
+	return Of(c.Prime.Complement())
+}
+
+// String returns the name and the prime form of the set class, e.g. "4-Z15 (0146)".
+func (c SetClass) String() string {
+	if c.Empty {
+		return ""
+	}
+	return c.Name + " (" + formatPrimeForm(c.Prime) + ")"
+}
+
+const digits = "0123456789TE"
+
+func parsePrimeForm(form string) pcset.Set {
+	var pcs []int
+	for _, digit := range form {
+		pcs = append(pcs, strings.IndexRune(digits, digit))
+	}
+	return pcset.SetOf(pcs...)
+}
+
+func formatPrimeForm(set pcset.Set) string {
+	form := ""
+	for _, pc := range set.PitchClasses() {
+		form += string(digits[pc])
+	}
+	return form
+}
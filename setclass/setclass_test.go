@@ -0,0 +1,106 @@
+package setclass
+
+import (
+	"testing"
+
+	"github.com/Golevka2001/go-chord-detector/pcset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogue(t *testing.T) {
+	all := All()
+	assert.Len(t, all, 224)
+
+	counts := make(map[int]int)
+	primes := make(map[pcset.Set]string)
+	for _, c := range all {
+		counts[c.Cardinality]++
+		if other, exists := primes[c.Prime]; exists {
+			t.Errorf("%s and %s have the same prime form", other, c.Name)
+		}
+		primes[c.Prime] = c.Name
+		assert.Equal(t, c.Cardinality, c.Prime.Len())
+	}
+	assert.Equal(t, map[int]int{0: 1, 1: 1, 2: 6, 3: 12, 4: 29, 5: 38, 6: 50, 7: 38, 8: 29, 9: 12, 10: 6, 11: 1, 12: 1}, counts)
+
+	for cardinality, forms := range primeForms {
+		for i, form := range forms {
+			c := all[index(cardinality, i+1)]
+			assert.Equal(t, form, formatPrimeForm(c.FortePrime), "%s should be Forte's prime form", c.Name)
+		}
+	}
+}
+
+func index(cardinality int, number int) int {
+	for i, c := range All() {
+		if c.Cardinality == cardinality && c.Number == number {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestZ(t *testing.T) {
+	var names []string
+	for _, c := range All() {
+		if c.Z && c.Cardinality <= 6 {
+			names = append(names, c.Name)
+		}
+	}
+	assert.Equal(t, []string{
+		"4-Z15", "4-Z29", "5-Z12", "5-Z17", "5-Z18", "5-Z36", "5-Z37", "5-Z38",
+		"6-Z3", "6-Z4", "6-Z6", "6-Z10", "6-Z11", "6-Z12", "6-Z13", "6-Z17", "6-Z19", "6-Z23",
+		"6-Z24", "6-Z25", "6-Z26", "6-Z28", "6-Z29", "6-Z36", "6-Z37", "6-Z38", "6-Z39", "6-Z40",
+		"6-Z41", "6-Z42", "6-Z43", "6-Z44", "6-Z45", "6-Z46", "6-Z47", "6-Z48", "6-Z49", "6-Z50",
+	}, names)
+
+	assert.Equal(t, []SetClass{Get("4-Z29")}, Get("4-Z15").ZPartners())
+	assert.Equal(t, []SetClass{Get("6-Z36")}, Get("6-Z3").ZPartners())
+	assert.Empty(t, Get("3-11").ZPartners())
+	assert.Equal(t, "8-Z15", Get("4-Z15").Complement().Name)
+	assert.Equal(t, "6-Z36", Get("6-Z3").Complement().Name, "Z hexachords are the complements of their partners")
+	assert.Equal(t, "6-1", Get("6-1").Complement().Name)
+}
+
+func TestGet(t *testing.T) {
+	c := Get("4-Z15")
+	assert.Equal(t, c, Get("4-15"))
+	assert.Equal(t, "4-Z15 (0146)", c.String())
+	assert.Equal(t, [6]int{1, 1, 1, 1, 1, 1}, c.IntervalVector)
+	assert.True(t, Get("4-16").Z == false)
+	assert.True(t, Get("13-1").Empty)
+	assert.Equal(t, "12-1", Get("12-1").Name)
+	assert.Equal(t, "2-6 (06)", Get("2-6").String())
+}
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, "3-11", Of(pcset.SetOf(0, 4, 7)).Name, "Major triad")
+	assert.Equal(t, "3-11", Of(pcset.SetOf(9, 0, 4)).Name, "Minor triad")
+	assert.Equal(t, "4-27", Of(pcset.SetOf(7, 11, 2, 5)).Name, "Dominant seventh")
+	assert.Equal(t, "7-35", Of(pcset.SetOf(0, 2, 4, 5, 7, 9, 11)).Name, "Diatonic scale")
+	assert.Equal(t, "6-35", Of(pcset.SetOf(0, 2, 4, 6, 8, 10)).Name, "Whole tone scale")
+	assert.Equal(t, "8-28", Of(pcset.SetOf(0, 1, 3, 4, 6, 7, 9, 10)).Name, "Octatonic scale")
+	assert.Equal(t, "0-1", Of(pcset.EmptySet).Name)
+}
+
+func TestRahnAndForte(t *testing.T) {
+	// Forte's and Rahn's prime forms.
+	differences := map[string][2]string{
+		"5-20":  {"01378", "01568"},
+		"6-Z29": {"013689", "023679"},
+		"6-31":  {"013589", "014579"},
+		"7-Z18": {"0123589", "0145679"},
+		"7-20":  {"0124789", "0125679"},
+		"8-26":  {"0124579T", "0134578T"},
+	}
+	for _, c := range All() {
+		if _, listed := differences[c.Name]; !listed {
+			assert.Equal(t, c.Prime, c.FortePrime, c.Name)
+		}
+	}
+	for name, forms := range differences {
+		c := Get(name)
+		assert.Equal(t, forms[0], formatPrimeForm(c.FortePrime), name)
+		assert.Equal(t, forms[1], formatPrimeForm(c.Prime), name)
+	}
+}
@@ -0,0 +1,350 @@
+// Generate concrete voicings (notes with octaves) for a chord.
+package voicing
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	detector "github.com/Golevka2001/go-chord-detector"
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+	"github.com/Golevka2001/go-chord-detector/pitchinterval"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+type Style string
+
+const (
+	// Close position: all the chord tones within an octave, in every inversion.
+	Close Style = "close"
+	// Open position: close position with the second voice from the bottom raised an octave.
+	Open Style = "open"
+	// Drop 2: close position with the second voice from the top dropped an octave.
+	Drop2 Style = "drop-2"
+	// Drop 3: close position with the third voice from the top dropped an octave.
+	Drop3 Style = "drop-3"
+	// Shell: root, third and seventh (or sixth), in both orders.
+	Shell Style = "shell"
+	// Rootless A: the chord tones but the root, in close position from the third.
+	RootlessA Style = "rootless A"
+	// Rootless B: the chord tones but the root, in close position from the seventh.
+	RootlessB Style = "rootless B"
+	// Spread: root and seventh (or fifth) in the left hand, the other tones above.
+	Spread Style = "spread"
+)
+
+// Styles lists all the styles, in the order voicings are returned.
+var Styles = []Style{Close, Open, Drop2, Drop3, Shell, RootlessA, RootlessB, Spread}
+
+// Voicing is a set of notes, from the lowest to the highest.
+type Voicing struct {
+	Style Style
+	Notes []*note.Note
+}
+
+// Options limits the generated voicings.
+//
+// Low and High are the lowest and highest notes allowed (C2 and C6 when nil).
+// MaxSpan is the largest distance in semitones between the lowest and the
+// highest note, 0 means no limit. Styles restricts the styles, all styles are
+// generated when empty.
+type Options struct {
+	Low     *note.Note
+	High    *note.Note
+	MaxSpan int
+	Styles  []Style
+}
+
+var (
+	defaultLow  = &note.Note{Class: note.C, Octave: 2}
+	defaultHigh = &note.Note{Class: note.C, Octave: 6}
+)
+
+// Generate returns the voicings of a chord.
+//
+// Every voicing is checked with detector.Detect: only the voicings that are
+// detected as the same chord are returned (with a slash bass when the lowest note
+// is not the root). Voicings without a perfect fifth are checked with the
+// AssumePerfectFifth option. Rootless voicings leave the root to the bass
+// player: they are checked with the root played below them.
+func Generate(root note.Class, chordType chordtype.ChordType, options Options) []Voicing {
+	if root == note.Nil || chordType.Empty {
+		return make([]Voicing, 0)
+	}
+
+	low, high := pitch(defaultLow), pitch(defaultHigh)
+	if options.Low != nil {
+		low = pitch(options.Low)
+	}
+	if options.High != nil {
+		high = pitch(options.High)
+	}
+	styles := options.Styles
+	if len(styles) == 0 {
+		styles = Styles
+	}
+
+	tones := chordTones(chordType)
+	result := make([]Voicing, 0)
+	for _, style := range styles {
+		seen := make(map[string]bool)
+		for _, shape := range shapes(style, tones) {
+			for _, pitches := range place(int(root)-1, shape, low, high) {
+				if options.MaxSpan > 0 && pitches[len(pitches)-1]-pitches[0] > options.MaxSpan {
+					continue
+				}
+				key := pitchesKey(pitches)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				notes := toNotes(pitches)
+				if style == RootlessA || style == RootlessB {
+					if !rootlessRoundTrip(notes, root, chordType) {
+						continue
+					}
+				} else if !roundTrip(notes, root, chordType) {
+					continue
+				}
+				result = append(result, Voicing{Style: style, Notes: notes})
+			}
+		}
+	}
+	return result
+}
+
+// Span returns the distance in semitones between the lowest and the highest note.
+func (v Voicing) Span() int {
+	if len(v.Notes) == 0 {
+		return 0
+	}
+	return pitch(v.Notes[len(v.Notes)-1]) - pitch(v.Notes[0])
+}
+
+// String returns the notes of the voicing, e.g. "C4 E4 G4 B4".
+func (v Voicing) String() string {
+	names := make([]string, len(v.Notes))
+	for i, n := range v.Notes {
+		names[i] = n.Class.String(note.Sharp) + strconv.Itoa(int(n.Octave))
+	}
+	return strings.Join(names, " ")
+}
+
+// tones are the semitones above the root of each chord tone, by interval order,
+// reduced to one octave.
+type tones struct {
+	all     []int
+	third   int
+	fifth   int
+	seventh int
+}
+
+func chordTones(chordType chordtype.ChordType) tones {
+	t := tones{third: -1, fifth: -1, seventh: -1}
+	seen := make(map[int]bool)
+	for _, name := range chordType.Intervals {
+		interval := pitchinterval.Parse(name)
+		if interval.Empty || seen[interval.Chroma] {
+			continue
+		}
+		seen[interval.Chroma] = true
+		t.all = append(t.all, interval.Chroma)
+
+		switch interval.Simple {
+		case 3:
+			if t.third == -1 {
+				t.third = interval.Chroma
+			}
+		case 5:
+			if t.fifth == -1 {
+				t.fifth = interval.Chroma
+			}
+		case 6, 7:
+			if interval.Simple == 7 || t.seventh == -1 {
+				t.seventh = interval.Chroma
+			}
+		}
+	}
+	return t
+}
+
+// shapes returns the semitones above the root of the voices of a style, from
+// the lowest to the highest.
+func shapes(style Style, t tones) [][]int {
+	sorted := append([]int(nil), t.all...)
+	sort.Ints(sorted)
+	size := len(sorted)
+
+	var result [][]int
+	switch style {
+	case Close, Open, Drop2, Drop3:
+		for inversion := 0; inversion < size; inversion++ {
+			voices := closePosition(sorted, sorted[inversion])
+			switch style {
+			case Open:
+				if size < 3 {
+					continue
+				}
+				voices[1] += 12
+			case Drop2:
+				if size < 3 {
+					continue
+				}
+				voices[size-2] -= 12
+			case Drop3:
+				if size < 4 {
+					continue
+				}
+				voices[size-3] -= 12
+			}
+			sort.Ints(voices)
+			result = append(result, voices)
+		}
+	case Shell:
+		third := t.third
+		if third == -1 {
+			third = 5 // suspended chords use the fourth
+		}
+		if t.seventh == -1 || !contains(sorted, third) {
+			return nil
+		}
+		result = append(result,
+			[]int{0, third, t.seventh},
+			[]int{0, t.seventh, third + 12},
+		)
+	case RootlessA, RootlessB:
+		start := t.third
+		if style == RootlessB {
+			start = t.seventh
+		}
+		upper := without(sorted, 0)
+		if start == -1 || len(upper) < 3 {
+			return nil
+		}
+		result = append(result, closePosition(upper, start))
+	case Spread:
+		left := t.seventh
+		if left == -1 {
+			left = t.fifth
+		}
+		upper := without(without(sorted, 0), left)
+		if left == -1 || len(upper) == 0 {
+			return nil
+		}
+		voices := closePosition(upper, upper[0])
+		for voices[0] <= left {
+			voices = shift(voices, 12)
+		}
+		result = append(result, append([]int{0, left}, voices...))
+	}
+	return result
+}
+
+// closePosition stacks the tones within an octave, starting from the given one.
+func closePosition(sorted []int, start int) []int {
+	voices := make([]int, 0, len(sorted))
+	for _, tone := range sorted {
+		if tone >= start {
+			voices = append(voices, tone)
+		}
+	}
+	for _, tone := range sorted {
+		if tone < start {
+			voices = append(voices, tone+12)
+		}
+	}
+	return voices
+}
+
+// place returns the pitches of a shape for every octave of the root within the range.
+func place(root int, shape []int, low int, high int) [][]int {
+	var result [][]int
+	for base := root - 12*12; base <= high; base += 12 {
+		pitches := shift(shape, base)
+		if pitches[0] >= low && pitches[len(pitches)-1] <= high {
+			result = append(result, pitches)
+		}
+	}
+	return result
+}
+
+func roundTrip(notes []*note.Note, root note.Class, chordType chordtype.ChordType) bool {
+	expected := root.String(note.Sharp)
+	if len(chordType.Aliases) > 0 {
+		expected += chordType.Aliases[0]
+	}
+	if bass := notes[0].Class; bass != root {
+		expected += "/" + bass.String(note.Sharp)
+	}
+
+	for _, assume := range []bool{false, true} {
+		for _, name := range detector.DetectWithOptions(notes, detector.DetectOptions{AssumePerfectFifth: assume}) {
+			if name == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rootlessRoundTrip returns true if the notes are detected as the chord once the
+// root is played below them, as the bass player does under rootless voicings.
+func rootlessRoundTrip(notes []*note.Note, root note.Class, chordType chordtype.ChordType) bool {
+	// The root below the lowest note.
+	bass := pitch(notes[0]) - 1
+	bass -= ((bass-int(root)+1)%12 + 12) % 12
+	return roundTrip(append(toNotes([]int{bass}), notes...), root, chordType)
+}
+
+// pitch returns the number of semitones above C0.
+func pitch(n *note.Note) int {
+	return int(n.Octave)*12 + int(n.Class) - 1
+}
+
+func toNotes(pitches []int) []*note.Note {
+	notes := make([]*note.Note, len(pitches))
+	for i, p := range pitches {
+		octave := p / 12
+		if p < 0 && p%12 != 0 {
+			octave--
+		}
+		notes[i] = &note.Note{Class: note.Class(p-octave*12) + 1, Octave: note.Octave(octave)}
+	}
+	return notes
+}
+
+func pitchesKey(pitches []int) string {
+	parts := make([]string, len(pitches))
+	for i, p := range pitches {
+		parts[i] = strconv.Itoa(p)
+	}
+	return strings.Join(parts, " ")
+}
+
+func shift(voices []int, by int) []int {
+	result := make([]int, len(voices))
+	for i, v := range voices {
+		result[i] = v + by
+	}
+	return result
+}
+
+func without(tones []int, tone int) []int {
+	result := make([]int, 0, len(tones))
+	for _, t := range tones {
+		if t != tone {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func contains(tones []int, tone int) bool {
+	for _, t := range tones {
+		if t == tone {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package voicing
+
+import (
+	"testing"
+
+	detector "github.com/Golevka2001/go-chord-detector"
+	"github.com/Golevka2001/go-chord-detector/chordtype"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/stretchr/testify/assert"
+)
+
+func voicingStrings(voicings []Voicing, style Style) []string {
+	var result []string
+	for _, v := range voicings {
+		if v.Style == style {
+			result = append(result, v.String())
+		}
+	}
+	return result
+}
+
+func TestGenerate(t *testing.T) {
+	options := Options{
+		Low:  &note.Note{Class: note.C, Octave: 3},
+		High: &note.Note{Class: note.C, Octave: 5},
+	}
+	voicings := Generate(note.C, chordtype.Get("maj7"), options)
+
+	assert.Equal(t, []string{
+		"C3 E3 G3 B3", "C4 E4 G4 B4",
+		"E3 G3 B3 C4", "E4 G4 B4 C5",
+		"G3 B3 C4 E4",
+		"B3 C4 E4 G4",
+	}, voicingStrings(voicings, Close))
+	assert.Contains(t, voicingStrings(voicings, Drop2), "C3 G3 B3 E4")
+	assert.Contains(t, voicingStrings(voicings, Drop3), "C3 B3 E4 G4")
+	assert.Equal(t, []string{"C3 E3 B3", "C4 E4 B4", "C3 B3 E4"}, voicingStrings(voicings, Shell))
+	assert.Equal(t, []string{"C3 G3 B3 E4", "E3 B3 C4 G4", "G3 C4 E4 B4", "B3 E4 G4 C5"}, voicingStrings(voicings, Open))
+	assert.Equal(t, []string{"C3 B3 E4 G4"}, voicingStrings(voicings, Spread))
+}
+
+func TestRootless(t *testing.T) {
+	voicings := Generate(note.C, chordtype.Get("maj9"), Options{
+		Styles: []Style{RootlessA, RootlessB},
+		Low:    &note.Note{Class: note.C, Octave: 2},
+		High:   &note.Note{Class: note.G, Octave: 4},
+	})
+	assert.Equal(t, []string{"E2 G2 B2 D3", "E3 G3 B3 D4"}, voicingStrings(voicings, RootlessA))
+	assert.Equal(t, []string{"B2 D3 E3 G3", "B3 D4 E4 G4"}, voicingStrings(voicings, RootlessB))
+	for _, v := range voicings {
+		for _, n := range v.Notes {
+			assert.NotEqual(t, note.C, n.Class, "%s %s should have no root", v.Style, v.String())
+		}
+	}
+}
+
+func TestMaxSpan(t *testing.T) {
+	voicings := Generate(note.D, chordtype.Get("m7"), Options{MaxSpan: 12})
+	assert.NotEmpty(t, voicings)
+	for _, v := range voicings {
+		assert.LessOrEqual(t, v.Span(), 12, v.String())
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, chordType := range chordtype.All() {
+		for _, v := range Generate(note.Fs, chordType, Options{}) {
+			if v.Style == RootlessA || v.Style == RootlessB {
+				for _, n := range v.Notes {
+					assert.NotEqual(t, note.Fs, n.Class, "%s %s", v.Style, v.String())
+				}
+				continue
+			}
+			expected := "F#" + chordType.Aliases[0]
+			if v.Notes[0].Class != note.Fs {
+				expected += "/" + v.Notes[0].Class.String(note.Sharp)
+			}
+			found := append(detector.Detect(v.Notes),
+				detector.DetectWithOptions(v.Notes, detector.DetectOptions{AssumePerfectFifth: true})...)
+			assert.Contains(t, found, expected, "%s %s", v.Style, v.String())
+		}
+	}
+}
+
+func TestGenerateEmpty(t *testing.T) {
+	assert.Empty(t, Generate(note.Nil, chordtype.Get("maj7"), Options{}))
+	assert.Empty(t, Generate(note.C, chordtype.NoChordType, Options{}))
+}